@@ -0,0 +1,142 @@
+package apigee
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apigeeApiProductsPageSize is the largest number of API products the API
+// returns in a single list call.
+const apigeeApiProductsPageSize = 1000
+
+func DataSourceApigeeApiProducts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceApigeeApiProductsRead,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The Apigee organization of the API products, in the format organizations/{org_name}.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("display_name", "approval_type"),
+			"api_products": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The internal name of the API product.`,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name displayed in the UI or developer portal to developers registering for API access.`,
+						},
+						"approval_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `How API keys are approved to access the APIs defined by the API product, either manual or auto.`,
+						},
+						"environments": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The environments in which the API product is available.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApigeeApiProductsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{ApigeeBasePath}}{{org_id}}/apiproducts")
+	if err != nil {
+		return err
+	}
+
+	// The API pages with startKey, the name of the first API product of the
+	// page, rather than with page tokens. Each page after the first therefore
+	// starts with the last API product of the previous page.
+	apiProducts := make([]map[string]interface{}, 0)
+	params := map[string]string{
+		"expand": "true",
+		"count":  strconv.Itoa(apigeeApiProductsPageSize),
+	}
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing Apigee API products: %s", err)
+		}
+
+		items, _ := res["apiProduct"].([]interface{})
+		page := flattenApigeeApiProducts(items)
+		if startKey, ok := params["startKey"]; ok && len(page) > 0 && page[0]["name"] == startKey {
+			page = page[1:]
+		}
+		apiProducts = append(apiProducts, page...)
+
+		if len(items) < apigeeApiProductsPageSize || len(page) == 0 {
+			break
+		}
+		params["startKey"] = page[len(page)-1]["name"].(string)
+	}
+
+	if err := d.Set("api_products", tpgresource.ApplyDatasourceFilters(filters, apiProducts)); err != nil {
+		return fmt.Errorf("Error setting Apigee API products: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/apiproducts", d.Get("org_id").(string)))
+
+	return nil
+}
+
+func flattenApigeeApiProducts(items []interface{}) []map[string]interface{} {
+	apiProducts := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		apiProduct, ok := item.(map[string]interface{})
+		if !ok || len(apiProduct) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		name, _ := apiProduct["name"].(string)
+
+		apiProducts = append(apiProducts, map[string]interface{}{
+			"name":          name,
+			"display_name":  apiProduct["displayName"],
+			"approval_type": apiProduct["approvalType"],
+			"environments":  apiProduct["environments"],
+		})
+	}
+	return apiProducts
+}
@@ -0,0 +1,63 @@
+package apigee
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestDataSourceApigeeApiProducts_pagination(t *testing.T) {
+	names := make([]string, 0, 1500)
+	for i := 0; i < 1500; i++ {
+		names = append(names, fmt.Sprintf("product-%04d", i))
+	}
+
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		count, _ := strconv.Atoi(r.URL.Query().Get("count"))
+		start := sort.SearchStrings(names, r.URL.Query().Get("startKey"))
+		end := start + count
+		if end > len(names) {
+			end = len(names)
+		}
+
+		products := make([]map[string]interface{}, 0, end-start)
+		for _, name := range names[start:end] {
+			products = append(products, map[string]interface{}{"name": name, "approvalType": "auto"})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"apiProduct": products})
+	}))
+	defer ts.Close()
+
+	config := &transport_tpg.Config{
+		ApigeeBasePath: ts.URL + "/",
+		Client:         ts.Client(),
+		Context:        context.Background(),
+	}
+	d := schema.TestResourceDataRaw(t, DataSourceApigeeApiProducts().Schema, map[string]interface{}{
+		"org_id": "organizations/my-org",
+	})
+
+	if err := dataSourceApigeeApiProductsRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 list calls, got %d", requests)
+	}
+	if got := d.Get("api_products.#").(int); got != len(names) {
+		t.Fatalf("expected %d API products, got %d", len(names), got)
+	}
+	if got := d.Get("api_products.1000.name").(string); got != "product-1000" {
+		t.Errorf("expected the second page to continue with product-1000, got %q", got)
+	}
+}
@@ -0,0 +1,151 @@
+package apigee_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccDataSourceApigeeApiProducts_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"billing_account": envvar.GetTestBillingAccountFromEnv(t),
+		"org_id":          envvar.GetTestOrgFromEnv(t),
+		"random_suffix":   acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {},
+		},
+		CheckDestroy: testAccCheckApigeeApiProductDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceApigeeApiProducts_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_apigee_api_products.manual", "api_products.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_apigee_api_products.manual", "api_products.0.name", "google_apigee_api_product.manual", "name"),
+					resource.TestCheckResourceAttr("data.google_apigee_api_products.manual", "api_products.0.display_name", "Partner API Product"),
+					resource.TestCheckResourceAttr("data.google_apigee_api_products.manual", "api_products.0.approval_type", "manual"),
+					resource.TestCheckResourceAttr("data.google_apigee_api_products.manual", "api_products.0.environments.#", "1"),
+					resource.TestCheckResourceAttr("data.google_apigee_api_products.manual", "api_products.0.environments.0", "dev"),
+					resource.TestCheckResourceAttr("data.google_apigee_api_products.all", "api_products.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceApigeeApiProducts_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_project" "project" {
+  project_id      = "tf-test%{random_suffix}"
+  name            = "tf-test%{random_suffix}"
+  org_id          = "%{org_id}"
+  billing_account = "%{billing_account}"
+  deletion_policy = "DELETE"
+}
+resource "time_sleep" "wait_60_seconds" {
+  create_duration = "60s"
+  depends_on = [google_project.project]
+}
+resource "google_project_service" "apigee" {
+  project = google_project.project.project_id
+  service = "apigee.googleapis.com"
+  depends_on = [time_sleep.wait_60_seconds]
+}
+resource "google_project_service" "compute" {
+  project = google_project.project.project_id
+  service = "compute.googleapis.com"
+  depends_on = [google_project_service.apigee]
+}
+resource "google_project_service" "servicenetworking" {
+  project = google_project.project.project_id
+  service = "servicenetworking.googleapis.com"
+  depends_on = [google_project_service.compute]
+}
+resource "time_sleep" "wait_120_seconds" {
+  create_duration = "120s"
+  depends_on = [google_project_service.servicenetworking]
+}
+resource "google_compute_network" "apigee_network" {
+  name       = "apigee-network"
+  project    = google_project.project.project_id
+  depends_on = [time_sleep.wait_120_seconds]
+}
+resource "google_compute_global_address" "apigee_range" {
+  name          = "apigee-range"
+  purpose       = "VPC_PEERING"
+  address_type  = "INTERNAL"
+  prefix_length = 16
+  network       = google_compute_network.apigee_network.id
+  project       = google_project.project.project_id
+}
+resource "google_service_networking_connection" "apigee_vpc_connection" {
+  network                 = google_compute_network.apigee_network.id
+  service                 = "servicenetworking.googleapis.com"
+  reserved_peering_ranges = [google_compute_global_address.apigee_range.name]
+  depends_on              = [google_project_service.servicenetworking]
+}
+resource "google_apigee_organization" "apigee_org" {
+  analytics_region   = "us-central1"
+  project_id         = google_project.project.project_id
+  authorized_network = google_compute_network.apigee_network.id
+  depends_on         = [
+    google_service_networking_connection.apigee_vpc_connection,
+    google_project_service.apigee,
+  ]
+}
+resource "google_apigee_environment" "env_dev" {
+  name   = "dev"
+  org_id = google_apigee_organization.apigee_org.id
+}
+resource "google_apigee_api_product" "auto" {
+  org_id        = google_apigee_organization.apigee_org.id
+  name          = "tf-test-auto%{random_suffix}"
+  display_name  = "Public API Product"
+  approval_type = "auto"
+  environments  = [google_apigee_environment.env_dev.name]
+}
+resource "google_apigee_api_product" "manual" {
+  org_id        = google_apigee_organization.apigee_org.id
+  name          = "tf-test-manual%{random_suffix}"
+  display_name  = "Partner API Product"
+  approval_type = "manual"
+  environments  = [google_apigee_environment.env_dev.name]
+}
+
+data "google_apigee_api_products" "manual" {
+  org_id = google_apigee_organization.apigee_org.id
+
+  filters {
+    name   = "approval_type"
+    values = ["^manual$"]
+  }
+
+  depends_on = [
+    google_apigee_api_product.auto,
+    google_apigee_api_product.manual,
+  ]
+}
+
+data "google_apigee_api_products" "all" {
+  org_id = google_apigee_organization.apigee_org.id
+
+  filters {
+    name   = "display_name"
+    values = ["API Product$"]
+  }
+
+  depends_on = [
+    google_apigee_api_product.auto,
+    google_apigee_api_product.manual,
+  ]
+}
+`, context)
+}
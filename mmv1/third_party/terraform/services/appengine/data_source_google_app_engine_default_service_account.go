@@ -2,10 +2,17 @@ package appengine
 
 import (
 	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-google/google/tpgiamresource"
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/appengine/v1"
+	"google.golang.org/api/cloudresourcemanager/v1"
 )
 
 func DataSourceGoogleAppEngineDefaultServiceAccount() *schema.Resource {
@@ -17,6 +24,14 @@ func DataSourceGoogleAppEngineDefaultServiceAccount() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"project_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"email": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -37,6 +52,19 @@ func DataSourceGoogleAppEngineDefaultServiceAccount() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"include_project_roles": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"project_roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -54,6 +82,15 @@ func dataSourceGoogleAppEngineDefaultServiceAccountRead(d *schema.ResourceData,
 	}
 
 	serviceAccountEmail := fmt.Sprintf("%s@appspot.gserviceaccount.com", project)
+	if v, ok := d.GetOk("service"); ok {
+		email, err := appEngineServiceAccountEmail(config.NewAppEngineClient(userAgent), project, v.(string))
+		if err != nil {
+			return err
+		}
+		if email != "" {
+			serviceAccountEmail = email
+		}
+	}
 
 	serviceAccountName, err := tpgresource.ServiceAccountFQN(serviceAccountEmail, d, config)
 	if err != nil {
@@ -62,6 +99,9 @@ func dataSourceGoogleAppEngineDefaultServiceAccountRead(d *schema.ResourceData,
 
 	sa, err := config.NewIamClient(userAgent).Projects.ServiceAccounts.Get(serviceAccountName).Do()
 	if err != nil {
+		if transport_tpg.IsGoogleApiErrorWithCode(err, 403) {
+			return &appEngineServiceAccountPermissionDeniedError{Email: serviceAccountEmail, Err: err}
+		}
 		return transport_tpg.HandleDataSourceNotFoundError(err, d, fmt.Sprintf("Service Account %q", serviceAccountName), serviceAccountName)
 	}
 
@@ -75,6 +115,20 @@ func dataSourceGoogleAppEngineDefaultServiceAccountRead(d *schema.ResourceData,
 	if err := d.Set("project", sa.ProjectId); err != nil {
 		return fmt.Errorf("Error setting project: %s", err)
 	}
+
+	// project_number is best-effort: reading the project needs the
+	// resourcemanager.projects.get permission and the Cloud Resource Manager
+	// API, which configs only reading the service account may not have.
+	projectNumber := ""
+	if p, err := config.NewResourceManagerClient(userAgent).Projects.Get(sa.ProjectId).Do(); err != nil {
+		log.Printf("[WARN] Unable to read project %q, leaving project_number empty: %s", sa.ProjectId, err)
+	} else {
+		projectNumber = strconv.FormatInt(p.ProjectNumber, 10)
+	}
+	if err := d.Set("project_number", projectNumber); err != nil {
+		return fmt.Errorf("Error setting project_number: %s", err)
+	}
+
 	if err := d.Set("name", sa.Name); err != nil {
 		return fmt.Errorf("Error setting name: %s", err)
 	}
@@ -84,6 +138,105 @@ func dataSourceGoogleAppEngineDefaultServiceAccountRead(d *schema.ResourceData,
 	if err := d.Set("member", "serviceAccount:"+sa.Email); err != nil {
 		return fmt.Errorf("Error setting member: %s", err)
 	}
+	if err := d.Set("disabled", sa.Disabled); err != nil {
+		return fmt.Errorf("Error setting disabled: %s", err)
+	}
+
+	var projectRoles []string
+	if d.Get("include_project_roles").(bool) {
+		policy, err := config.NewResourceManagerClient(userAgent).Projects.GetIamPolicy(project,
+			&cloudresourcemanager.GetIamPolicyRequest{
+				Options: &cloudresourcemanager.GetPolicyOptions{
+					RequestedPolicyVersion: tpgiamresource.IamPolicyVersion,
+				},
+			}).Do()
+		if err != nil {
+			return fmt.Errorf("Error retrieving IAM policy for project %q: %s", project, err)
+		}
+		projectRoles = rolesGrantedToMember(policy, "serviceAccount:"+sa.Email)
+	}
+	if err := d.Set("project_roles", projectRoles); err != nil {
+		return fmt.Errorf("Error setting project_roles: %s", err)
+	}
 
 	return nil
 }
+
+// appEngineServiceAccountPermissionDeniedError is returned when the IAM API
+// refuses to read the service account with a 403.
+type appEngineServiceAccountPermissionDeniedError struct {
+	Email string
+	Err   error
+}
+
+func (e *appEngineServiceAccountPermissionDeniedError) Error() string {
+	return fmt.Sprintf("Permission denied reading service account %q: the caller needs the iam.serviceAccounts.get permission on it, granted for example by roles/iam.serviceAccountViewer: %s", e.Email, e.Err)
+}
+
+func (e *appEngineServiceAccountPermissionDeniedError) Unwrap() error {
+	return e.Err
+}
+
+// rolesGrantedToMember returns the sorted roles that policy grants to member,
+// including conditional grants. The IAM policy of a project is returned whole,
+// so there are no pages to read, and members are matched case-insensitively
+// as IAM does.
+func rolesGrantedToMember(policy *cloudresourcemanager.Policy, member string) []string {
+	granted := make(map[string]struct{})
+	for _, binding := range policy.Bindings {
+		if _, ok := granted[binding.Role]; ok {
+			continue
+		}
+		for _, m := range binding.Members {
+			if strings.EqualFold(m, member) {
+				granted[binding.Role] = struct{}{}
+				break
+			}
+		}
+	}
+
+	roles := make([]string, 0, len(granted))
+	for role := range granted {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// appEngineServiceAccountEmail returns the service account the version serving
+// most of the traffic of service runs as, or "" when that version runs as the
+// default appspot service account.
+func appEngineServiceAccountEmail(client *appengine.APIService, project, service string) (string, error) {
+	svc, err := client.Apps.Services.Get(project, service).Do()
+	if err != nil {
+		return "", fmt.Errorf("Error reading App Engine service %q: %s", service, err)
+	}
+	if svc.Split == nil || len(svc.Split.Allocations) == 0 {
+		return "", fmt.Errorf("App Engine service %q has no version serving traffic", service)
+	}
+
+	version := appEngineServingVersion(svc.Split.Allocations)
+	v, err := client.Apps.Services.Versions.Get(project, service, version).View("FULL").Do()
+	if err != nil {
+		return "", fmt.Errorf("Error reading version %q of App Engine service %q: %s", version, service, err)
+	}
+	return v.ServiceAccount, nil
+}
+
+// appEngineServingVersion returns the version with the largest traffic
+// allocation, preferring the first version by name on a tie.
+func appEngineServingVersion(allocations map[string]float64) string {
+	versions := make([]string, 0, len(allocations))
+	for version := range allocations {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+
+	serving := versions[0]
+	for _, version := range versions[1:] {
+		if allocations[version] > allocations[serving] {
+			serving = version
+		}
+	}
+	return serving
+}
@@ -0,0 +1,99 @@
+package appengine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestAppEngineServingVersion(t *testing.T) {
+	cases := map[string]struct {
+		allocations map[string]float64
+		want        string
+	}{
+		"single version": {
+			allocations: map[string]float64{"v1": 1},
+			want:        "v1",
+		},
+		"traffic split": {
+			allocations: map[string]float64{"v1": 0.25, "v2": 0.75},
+			want:        "v2",
+		},
+		"tie": {
+			allocations: map[string]float64{"v2": 0.5, "v1": 0.5},
+			want:        "v1",
+		},
+	}
+	for tn, tc := range cases {
+		if got := appEngineServingVersion(tc.allocations); got != tc.want {
+			t.Errorf("%s: expected version %q, got %q", tn, tc.want, got)
+		}
+	}
+}
+
+func TestRolesGrantedToMember(t *testing.T) {
+	member := "serviceAccount:my-project@appspot.gserviceaccount.com"
+	policy := &cloudresourcemanager.Policy{
+		Bindings: []*cloudresourcemanager.Binding{
+			{Role: "roles/editor", Members: []string{"user:admin@example.com", member}},
+			{Role: "roles/viewer", Members: []string{"user:admin@example.com"}},
+			{Role: "roles/logging.logWriter", Members: []string{"serviceAccount:My-Project@appspot.gserviceaccount.com"}},
+			{
+				Role:      "roles/editor",
+				Members:   []string{member},
+				Condition: &cloudresourcemanager.Expr{Title: "expires", Expression: `request.time < timestamp("2030-01-01T00:00:00Z")`},
+			},
+		},
+	}
+
+	got := rolesGrantedToMember(policy, member)
+	if want := []string{"roles/editor", "roles/logging.logWriter"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected roles %v, got %v", want, got)
+	}
+	if got := rolesGrantedToMember(&cloudresourcemanager.Policy{}, member); len(got) != 0 {
+		t.Errorf("expected no roles for an empty policy, got %v", got)
+	}
+}
+
+func TestDataSourceGoogleAppEngineDefaultServiceAccount_permissionDenied(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": {"code": 403, "message": "Permission 'iam.serviceAccounts.get' denied on resource (or it may not exist).", "status": "PERMISSION_DENIED"}}`)
+	}))
+	defer ts.Close()
+
+	config := &transport_tpg.Config{
+		Project:     "my-project",
+		IAMBasePath: ts.URL + "/",
+		Client:      ts.Client(),
+		Context:     context.Background(),
+	}
+	d := schema.TestResourceDataRaw(t, DataSourceGoogleAppEngineDefaultServiceAccount().Schema, map[string]interface{}{})
+
+	err := dataSourceGoogleAppEngineDefaultServiceAccountRead(d, config)
+	var denied *appEngineServiceAccountPermissionDeniedError
+	if !errors.As(err, &denied) {
+		t.Fatalf("expected a permission denied error, got %v", err)
+	}
+	if denied.Email != "my-project@appspot.gserviceaccount.com" {
+		t.Errorf("expected the error to name the default service account, got %q", denied.Email)
+	}
+	for _, want := range []string{"iam.serviceAccounts.get", "my-project@appspot.gserviceaccount.com"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected the error to mention %q, got %q", want, err)
+		}
+	}
+	if !transport_tpg.IsGoogleApiErrorWithCode(errors.Unwrap(err), 403) {
+		t.Errorf("expected the error to wrap the 403, got %v", errors.Unwrap(err))
+	}
+}
@@ -1,6 +1,7 @@
 package appengine_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -20,11 +21,13 @@ func TestAccDataSourceGoogleAppEngineDefaultServiceAccount_basic(t *testing.T) {
 				Config: testAccCheckGoogleAppEngineDefaultServiceAccount_basic,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
-					resource.TestCheckResourceAttrSet(resourceName, "email"),
+					resource.TestMatchResourceAttr(resourceName, "email", regexp.MustCompile(`@appspot\.gserviceaccount\.com$`)),
 					resource.TestCheckResourceAttrSet(resourceName, "unique_id"),
+					resource.TestMatchResourceAttr(resourceName, "project_number", regexp.MustCompile(`^[0-9]+$`)),
 					resource.TestCheckResourceAttrSet(resourceName, "name"),
 					resource.TestCheckResourceAttrSet(resourceName, "display_name"),
 					resource.TestCheckResourceAttrSet(resourceName, "member"),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
 				),
 			},
 		},
@@ -34,3 +37,38 @@ func TestAccDataSourceGoogleAppEngineDefaultServiceAccount_basic(t *testing.T) {
 const testAccCheckGoogleAppEngineDefaultServiceAccount_basic = `
 data "google_app_engine_default_service_account" "default" {}
 `
+
+func TestAccDataSourceGoogleAppEngineDefaultServiceAccount_projectRoles(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.google_app_engine_default_service_account.default"
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGoogleAppEngineDefaultServiceAccount_projectRoles,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(resourceName, "project_roles.*", "roles/logging.logWriter"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckGoogleAppEngineDefaultServiceAccount_projectRoles = `
+data "google_app_engine_default_service_account" "sa" {}
+
+resource "google_project_iam_member" "log_writer" {
+  project = data.google_app_engine_default_service_account.sa.project
+  role    = "roles/logging.logWriter"
+  member  = data.google_app_engine_default_service_account.sa.member
+}
+
+data "google_app_engine_default_service_account" "default" {
+  include_project_roles = true
+
+  depends_on = [google_project_iam_member.log_writer]
+}
+`
@@ -0,0 +1,171 @@
+package apphub
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func DataSourceApphubDiscoveredServices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceApphubDiscoveredServicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the host project in which the services are discovered. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the discovered services. If it is not provided, discovered services across all App Hub locations of the project are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name"),
+			"discovered_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the discovered service.`,
+						},
+						"service_reference": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The reference to the resource backing the discovered service.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"uri": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The underlying resource URI.`,
+									},
+									"path": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The additional path under the resource URI, if any.`,
+									},
+								},
+							},
+						},
+						"service_properties": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The properties of the resource backing the discovered service.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"gcp_project": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The service project in which the resource lives.`,
+									},
+									"location": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The location of the resource, either a region or global.`,
+									},
+									"zone": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The zone of the resource, if any.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApphubDiscoveredServicesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for App Hub discovered services: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	locations := []string{d.Get("location").(string)}
+	id := fmt.Sprintf("projects/%s/locations/%s/discoveredServices", project, locations[0])
+	if locations[0] == "" {
+		locations, err = transport_tpg.ListLocationIds(config, billingProject, fmt.Sprintf("%sprojects/%s/locations", config.ApphubBasePath, project), userAgent, "locations", "locationId")
+		if err != nil {
+			return fmt.Errorf("Error listing App Hub locations: %s", err)
+		}
+		id = fmt.Sprintf("projects/%s/locations/-/discoveredServices", project)
+	}
+
+	items, err := transport_tpg.ListAcrossLocations(locations, func(location string) ([]interface{}, error) {
+		return listApphubDiscoveredServices(config, billingProject, project, location, userAgent)
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing App Hub discovered services: %s", err)
+	}
+	services := flattenApphubDiscoveredServices(items, d, config)
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("discovered_services", tpgresource.ApplyDatasourceFilters(filters, services)); err != nil {
+		return fmt.Errorf("Error setting App Hub discovered services: %s", err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+// listApphubDiscoveredServices returns the discovered services of a single
+// location.
+func listApphubDiscoveredServices(config *transport_tpg.Config, billingProject, project, location, userAgent string) ([]interface{}, error) {
+	url := fmt.Sprintf("%sprojects/%s/locations/%s/discoveredServices", config.ApphubBasePath, project, location)
+	return transport_tpg.ListAllPages(func(pageToken string) ([]interface{}, string, error) {
+		res, nextPageToken, err := transport_tpg.SendListRequest(config, billingProject, url, userAgent, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		items, _ := res["discoveredServices"].([]interface{})
+		return items, nextPageToken, nil
+	}, transport_tpg.SendRequestRetryOptions)
+}
+
+func flattenApphubDiscoveredServices(items []interface{}, d *schema.ResourceData, config *transport_tpg.Config) []map[string]interface{} {
+	services := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		service, ok := item.(map[string]interface{})
+		if !ok || len(service) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		services = append(services, map[string]interface{}{
+			"name":               flattenApphubDiscoveredServiceName(service["name"], d, config),
+			"service_reference":  flattenApphubDiscoveredServiceReference(service["serviceReference"], d, config),
+			"service_properties": flattenApphubDiscoveredServiceProperties(service["serviceProperties"], d, config),
+		})
+	}
+	return services
+}
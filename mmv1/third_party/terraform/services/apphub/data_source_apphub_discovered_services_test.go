@@ -0,0 +1,155 @@
+package apphub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccDataSourceApphubDiscoveredServices_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":          envvar.GetTestOrgFromEnv(t),
+		"random_suffix":   acctest.RandString(t, 10),
+		"billing_account": envvar.GetTestBillingAccountFromEnv(t),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceApphubDiscoveredServices_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_apphub_discovered_services.filtered", "discovered_services.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_apphub_discovered_services.filtered", "discovered_services.0.name", "data.google_apphub_discovered_service.catalog-service", "name"),
+					resource.TestCheckResourceAttrPair("data.google_apphub_discovered_services.filtered", "discovered_services.0.service_reference.0.uri", "data.google_apphub_discovered_service.catalog-service", "service_reference.0.uri"),
+					resource.TestCheckResourceAttr("data.google_apphub_discovered_services.filtered", "discovered_services.0.service_properties.0.location", "us-central1"),
+					resource.TestCheckResourceAttr("data.google_apphub_discovered_services.all_locations", "discovered_services.#", "1"),
+					resource.TestCheckResourceAttr("data.google_apphub_discovered_services.excluded", "discovered_services.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceApphubDiscoveredServices_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_project" "service_project" {
+  project_id      = "tf-test-ah-%{random_suffix}"
+  name            = "Service Project"
+  org_id          = "%{org_id}"
+  billing_account = "%{billing_account}"
+  deletion_policy = "DELETE"
+}
+
+resource "google_project_service" "compute_service_project" {
+  project = google_project.service_project.project_id
+  service = "compute.googleapis.com"
+}
+
+resource "time_sleep" "wait_120s" {
+  depends_on      = [google_project_service.compute_service_project]
+  create_duration = "120s"
+}
+
+resource "google_apphub_service_project_attachment" "service_project_attachment" {
+  service_project_attachment_id = google_project.service_project.project_id
+  depends_on                    = [time_sleep.wait_120s]
+}
+
+resource "google_compute_network" "ilb_network" {
+  name                    = "ilb-network-%{random_suffix}"
+  project                 = google_project.service_project.project_id
+  auto_create_subnetworks = false
+  depends_on              = [time_sleep.wait_120s]
+}
+
+resource "google_compute_subnetwork" "ilb_subnet" {
+  name          = "ilb-subnet-%{random_suffix}"
+  project       = google_project.service_project.project_id
+  ip_cidr_range = "10.0.1.0/24"
+  region        = "us-central1"
+  network       = google_compute_network.ilb_network.id
+}
+
+resource "google_compute_health_check" "default" {
+  name               = "health-check-%{random_suffix}"
+  project            = google_project.service_project.project_id
+  check_interval_sec = 1
+  timeout_sec        = 1
+
+  tcp_health_check {
+    port = "80"
+  }
+  depends_on = [time_sleep.wait_120s]
+}
+
+resource "google_compute_region_backend_service" "backend" {
+  name          = "backend-service-%{random_suffix}"
+  project       = google_project.service_project.project_id
+  region        = "us-central1"
+  health_checks = [google_compute_health_check.default.id]
+}
+
+resource "google_compute_forwarding_rule" "forwarding_rule" {
+  name                  = "forwarding-rule-%{random_suffix}"
+  project               = google_project.service_project.project_id
+  region                = "us-central1"
+  ip_version            = "IPV4"
+  load_balancing_scheme = "INTERNAL"
+  all_ports             = true
+  backend_service       = google_compute_region_backend_service.backend.id
+  network               = google_compute_network.ilb_network.id
+  subnetwork            = google_compute_subnetwork.ilb_subnet.id
+}
+
+resource "time_sleep" "wait_120s_for_resource_ingestion" {
+  depends_on      = [google_compute_forwarding_rule.forwarding_rule]
+  create_duration = "120s"
+}
+
+data "google_apphub_discovered_service" "catalog-service" {
+  location    = "us-central1"
+  service_uri = "//compute.googleapis.com/${google_compute_forwarding_rule.forwarding_rule.id}"
+  depends_on  = [time_sleep.wait_120s_for_resource_ingestion]
+}
+
+data "google_apphub_discovered_services" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name           = "name"
+    literal_values = [data.google_apphub_discovered_service.catalog-service.name]
+  }
+}
+
+data "google_apphub_discovered_services" "all_locations" {
+  filters {
+    name           = "name"
+    literal_values = [data.google_apphub_discovered_service.catalog-service.name]
+  }
+}
+
+data "google_apphub_discovered_services" "excluded" {
+  location = "us-central1"
+
+  filters {
+    name           = "name"
+    literal_values = [data.google_apphub_discovered_service.catalog-service.name]
+  }
+
+  filters {
+    name   = "name"
+    values = ["/discoveredServices/"]
+    negate = true
+  }
+}
+`, context)
+}
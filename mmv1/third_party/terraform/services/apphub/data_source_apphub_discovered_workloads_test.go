@@ -0,0 +1,159 @@
+package apphub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccDataSourceApphubDiscoveredWorkloads_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":          envvar.GetTestOrgFromEnv(t),
+		"random_suffix":   acctest.RandString(t, 10),
+		"billing_account": envvar.GetTestBillingAccountFromEnv(t),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceApphubDiscoveredWorkloads_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_apphub_discovered_workloads.filtered", "discovered_workloads.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_apphub_discovered_workloads.filtered", "discovered_workloads.0.name", "data.google_apphub_discovered_workload.catalog-workload", "name"),
+					resource.TestCheckResourceAttrPair("data.google_apphub_discovered_workloads.filtered", "discovered_workloads.0.workload_reference.0.uri", "data.google_apphub_discovered_workload.catalog-workload", "workload_reference.0.uri"),
+					resource.TestCheckResourceAttr("data.google_apphub_discovered_workloads.filtered", "discovered_workloads.0.workload_properties.0.location", "us-central1"),
+					resource.TestCheckResourceAttr("data.google_apphub_discovered_workloads.all_locations", "discovered_workloads.#", "1"),
+					resource.TestCheckResourceAttr("data.google_apphub_discovered_workloads.excluded", "discovered_workloads.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceApphubDiscoveredWorkloads_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_project" "service_project" {
+  project_id      = "tf-test-ah-%{random_suffix}"
+  name            = "Service Project"
+  org_id          = "%{org_id}"
+  billing_account = "%{billing_account}"
+  deletion_policy = "DELETE"
+}
+
+resource "google_project_service" "compute_service_project" {
+  project = google_project.service_project.project_id
+  service = "compute.googleapis.com"
+}
+
+resource "time_sleep" "wait_120s" {
+  depends_on      = [google_project_service.compute_service_project]
+  create_duration = "120s"
+}
+
+resource "google_apphub_service_project_attachment" "service_project_attachment" {
+  service_project_attachment_id = google_project.service_project.project_id
+  depends_on                    = [time_sleep.wait_120s]
+}
+
+resource "google_compute_network" "ilb_network" {
+  name                    = "l7-ilb-network-%{random_suffix}"
+  project                 = google_project.service_project.project_id
+  auto_create_subnetworks = false
+  depends_on              = [time_sleep.wait_120s]
+}
+
+resource "google_compute_subnetwork" "ilb_subnet" {
+  name          = "l7-ilb-subnetwork-%{random_suffix}"
+  project       = google_project.service_project.project_id
+  ip_cidr_range = "10.0.1.0/24"
+  region        = "us-central1"
+  network       = google_compute_network.ilb_network.id
+}
+
+resource "google_compute_instance_template" "instance_template" {
+  name         = "l7-ilb-mig-template-%{random_suffix}"
+  project      = google_project.service_project.project_id
+  machine_type = "e2-small"
+
+  network_interface {
+    network    = google_compute_network.ilb_network.id
+    subnetwork = google_compute_subnetwork.ilb_subnet.id
+  }
+
+  disk {
+    source_image = "debian-cloud/debian-12"
+    auto_delete  = true
+    boot         = true
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "google_compute_region_instance_group_manager" "mig" {
+  name    = "l7-ilb-mig1-%{random_suffix}"
+  project = google_project.service_project.project_id
+  region  = "us-central1"
+
+  version {
+    instance_template = google_compute_instance_template.instance_template.id
+    name              = "primary"
+  }
+
+  base_instance_name = "vm"
+  target_size        = 1
+}
+
+resource "time_sleep" "wait_120s_for_resource_ingestion" {
+  depends_on      = [google_compute_region_instance_group_manager.mig]
+  create_duration = "120s"
+}
+
+data "google_apphub_discovered_workload" "catalog-workload" {
+  location     = "us-central1"
+  workload_uri = "${replace(google_compute_region_instance_group_manager.mig.instance_group, "https://www.googleapis.com/compute/v1", "//compute.googleapis.com")}"
+  depends_on   = [time_sleep.wait_120s_for_resource_ingestion]
+}
+
+data "google_apphub_discovered_workloads" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name           = "name"
+    literal_values = [data.google_apphub_discovered_workload.catalog-workload.name]
+  }
+}
+
+data "google_apphub_discovered_workloads" "all_locations" {
+  filters {
+    name           = "name"
+    literal_values = [data.google_apphub_discovered_workload.catalog-workload.name]
+  }
+}
+
+data "google_apphub_discovered_workloads" "excluded" {
+  location = "us-central1"
+
+  filters {
+    name           = "name"
+    literal_values = [data.google_apphub_discovered_workload.catalog-workload.name]
+  }
+
+  filters {
+    name   = "name"
+    values = ["/discoveredWorkloads/"]
+    negate = true
+  }
+}
+`, context)
+}
@@ -0,0 +1,160 @@
+package backupdr
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceGoogleCloudBackupDRBackupVaults() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleCloudBackupDRBackupVaultsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the backup vaults are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the backup vaults. If it is not provided, backup vaults across all locations are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "state"),
+			"backup_vaults": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the backup vault.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The description of the backup vault.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The state of the backup vault, for example CREATING or ACTIVE.`,
+						},
+						"backup_minimum_enforced_retention_duration": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The minimum duration for which the backups in the vault are enforced to be retained, for example 100000s.`,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The labels of the backup vault, including labels configured outside of Terraform.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleCloudBackupDRBackupVaultsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for backup vaults: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	location := "-"
+	if v, ok := d.GetOk("location"); ok {
+		location = v.(string)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{BackupDRBasePath}}projects/{{project}}/locations/%s/backupVaults", location))
+	if err != nil {
+		return err
+	}
+
+	vaults := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing backup vaults: %s", err)
+		}
+
+		if items, ok := res["backupVaults"].([]interface{}); ok {
+			vaults = append(vaults, flattenGoogleCloudBackupDRBackupVaults(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("backup_vaults", tpgresource.ApplyDatasourceFilters(filters, vaults)); err != nil {
+		return fmt.Errorf("Error setting backup vaults: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/backupVaults", project, location))
+
+	return nil
+}
+
+func flattenGoogleCloudBackupDRBackupVaults(items []interface{}) []map[string]interface{} {
+	vaults := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		vault, ok := item.(map[string]interface{})
+		if !ok || len(vault) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		vaults = append(vaults, map[string]interface{}{
+			"name":        vault["name"],
+			"description": vault["description"],
+			"state":       vault["state"],
+			"backup_minimum_enforced_retention_duration": vault["backupMinimumEnforcedRetentionDuration"],
+			"labels": vault["labels"],
+		})
+	}
+	return vaults
+}
@@ -0,0 +1,81 @@
+package backupdr_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceGoogleBackupDRBackupVaults_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckBackupDRBackupVaultDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleBackupDRBackupVaults_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_backup_dr_backup_vaults.filtered", "backup_vaults.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_backup_dr_backup_vaults.filtered", "backup_vaults.0.name", "google_backup_dr_backup_vault.test-bv", "name"),
+					resource.TestCheckResourceAttr("data.google_backup_dr_backup_vaults.filtered", "backup_vaults.0.description", "This is a a backup vault built by Terraform."),
+					resource.TestCheckResourceAttr("data.google_backup_dr_backup_vaults.filtered", "backup_vaults.0.state", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.google_backup_dr_backup_vaults.filtered", "backup_vaults.0.backup_minimum_enforced_retention_duration", "100000s"),
+					resource.TestCheckResourceAttr("data.google_backup_dr_backup_vaults.filtered", "backup_vaults.0.labels.environment", "dev"),
+					resource.TestCheckTypeSetElemAttrPair("data.google_backup_dr_backup_vaults.all_locations", "backup_vaults.*.name", "google_backup_dr_backup_vault.test-bv", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleBackupDRBackupVaults_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_backup_dr_backup_vault" "test-bv" {
+  location = "us-central1"
+  backup_vault_id = "tf-test-bv-%{random_suffix}"
+  description = "This is a a backup vault built by Terraform."
+  backup_minimum_enforced_retention_duration = "100000s"
+  force_update = "true"
+  force_delete = "true"
+  allow_missing = "true"
+  ignore_backup_plan_references = "false"
+  ignore_inactive_datasources = "false"
+
+  labels = {
+    environment = "dev"
+  }
+}
+
+data "google_backup_dr_backup_vaults" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name   = "name"
+    values = ["/backupVaults/tf-test-bv-%{random_suffix}$"]
+  }
+
+  filters {
+    name   = "state"
+    values = ["^ACTIVE$"]
+  }
+
+  depends_on = [google_backup_dr_backup_vault.test-bv]
+}
+
+data "google_backup_dr_backup_vaults" "all_locations" {
+  filters {
+    name   = "name"
+    values = ["/backupVaults/tf-test-bv-%{random_suffix}$"]
+  }
+
+  depends_on = [google_backup_dr_backup_vault.test-bv]
+}
+`, context)
+}
@@ -0,0 +1,147 @@
+package biglake
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceBiglakeCatalogs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBiglakeCatalogsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the catalogs are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the catalogs, for example US. If it is not provided, catalogs across all locations are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name"),
+			"catalogs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the catalog.`,
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The creation time of the catalog, in RFC3339 UTC format.`,
+						},
+						"expire_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The time at which the deleted catalog will be purged, in RFC3339 UTC format. Only set once the catalog is deleted.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBiglakeCatalogsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for BigLake catalogs: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	location := "-"
+	if v, ok := d.GetOk("location"); ok {
+		location = v.(string)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{BiglakeBasePath}}projects/{{project}}/locations/%s/catalogs", location))
+	if err != nil {
+		return err
+	}
+
+	catalogs := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing BigLake catalogs: %s", err)
+		}
+
+		if items, ok := res["catalogs"].([]interface{}); ok {
+			catalogs = append(catalogs, flattenBiglakeCatalogs(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("catalogs", tpgresource.ApplyDatasourceFilters(filters, catalogs)); err != nil {
+		return fmt.Errorf("Error setting BigLake catalogs: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/catalogs", project, location))
+
+	return nil
+}
+
+func flattenBiglakeCatalogs(items []interface{}) []map[string]interface{} {
+	catalogs := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		catalog, ok := item.(map[string]interface{})
+		if !ok || len(catalog) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		catalogs = append(catalogs, map[string]interface{}{
+			"name":        catalog["name"],
+			"create_time": catalog["createTime"],
+			"expire_time": catalog["expireTime"],
+		})
+	}
+	return catalogs
+}
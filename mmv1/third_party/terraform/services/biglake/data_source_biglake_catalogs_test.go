@@ -0,0 +1,65 @@
+package biglake_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceBiglakeCatalogs_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckBiglakeCatalogDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceBiglakeCatalogs_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_biglake_catalogs.filtered", "catalogs.#", "1"),
+					resource.TestMatchResourceAttr("data.google_biglake_catalogs.filtered", "catalogs.0.name", regexp.MustCompile(fmt.Sprintf("/locations/US/catalogs/tf_test_catalog_%s$", context["random_suffix"]))),
+					resource.TestCheckResourceAttrPair("data.google_biglake_catalogs.filtered", "catalogs.0.create_time", "google_biglake_catalog.catalog", "create_time"),
+					resource.TestCheckResourceAttr("data.google_biglake_catalogs.filtered", "catalogs.0.expire_time", ""),
+					resource.TestCheckResourceAttr("data.google_biglake_catalogs.all_locations", "catalogs.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceBiglakeCatalogs_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_biglake_catalog" "catalog" {
+  name     = "tf_test_catalog_%{random_suffix}"
+  location = "US"
+}
+
+data "google_biglake_catalogs" "filtered" {
+  location = "US"
+
+  filters {
+    name   = "name"
+    values = ["/catalogs/tf_test_catalog_%{random_suffix}$"]
+  }
+
+  depends_on = [google_biglake_catalog.catalog]
+}
+
+data "google_biglake_catalogs" "all_locations" {
+  filters {
+    name   = "name"
+    values = ["/catalogs/tf_test_catalog_%{random_suffix}$"]
+  }
+
+  depends_on = [google_biglake_catalog.catalog]
+}
+`, context)
+}
@@ -0,0 +1,161 @@
+package blockchainnodeengine
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceBlockchainNodeEngineBlockchainNodes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBlockchainNodeEngineBlockchainNodesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the blockchain nodes are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the blockchain nodes. If it is not provided, blockchain nodes across all locations are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "state", "blockchain_type"),
+			"blockchain_nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the blockchain node.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The state of the blockchain node, for example CREATING or RUNNING.`,
+						},
+						"blockchain_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The blockchain type of the node, for example ETHEREUM.`,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The labels of the blockchain node, including labels configured outside of Terraform.`,
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The location of the blockchain node.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBlockchainNodeEngineBlockchainNodesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for blockchain nodes: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	location := "-"
+	if v, ok := d.GetOk("location"); ok {
+		location = v.(string)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{BlockchainNodeEngineBasePath}}projects/{{project}}/locations/%s/blockchainNodes", location))
+	if err != nil {
+		return err
+	}
+
+	nodes := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing blockchain nodes: %s", err)
+		}
+
+		if items, ok := res["blockchainNodes"].([]interface{}); ok {
+			nodes = append(nodes, flattenBlockchainNodeEngineBlockchainNodes(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("blockchain_nodes", tpgresource.ApplyDatasourceFilters(filters, nodes)); err != nil {
+		return fmt.Errorf("Error setting blockchain nodes: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/blockchainNodes", project, location))
+
+	return nil
+}
+
+func flattenBlockchainNodeEngineBlockchainNodes(items []interface{}) []map[string]interface{} {
+	nodes := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		node, ok := item.(map[string]interface{})
+		if !ok || len(node) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		name, _ := node["name"].(string)
+		nodes = append(nodes, map[string]interface{}{
+			"name":            name,
+			"state":           node["state"],
+			"blockchain_type": node["blockchainType"],
+			"labels":          node["labels"],
+			"location":        tpgresource.GetRegionFromRegionalSelfLink(name),
+		})
+	}
+	return nodes
+}
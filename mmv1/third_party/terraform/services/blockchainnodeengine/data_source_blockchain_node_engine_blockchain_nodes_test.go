@@ -0,0 +1,90 @@
+package blockchainnodeengine_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceBlockchainNodeEngineBlockchainNodes_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckBlockchainNodeEngineBlockchainNodesDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceBlockchainNodeEngineBlockchainNodes_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_blockchain_node_engine_blockchain_nodes.filtered", "blockchain_nodes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_blockchain_node_engine_blockchain_nodes.filtered", "blockchain_nodes.0.name", "google_blockchain_node_engine_blockchain_nodes.node", "name"),
+					resource.TestCheckResourceAttr("data.google_blockchain_node_engine_blockchain_nodes.filtered", "blockchain_nodes.0.state", "RUNNING"),
+					resource.TestCheckResourceAttr("data.google_blockchain_node_engine_blockchain_nodes.filtered", "blockchain_nodes.0.blockchain_type", "ETHEREUM"),
+					resource.TestCheckResourceAttr("data.google_blockchain_node_engine_blockchain_nodes.filtered", "blockchain_nodes.0.labels.environment", "dev"),
+					resource.TestCheckResourceAttr("data.google_blockchain_node_engine_blockchain_nodes.filtered", "blockchain_nodes.0.location", "us-central1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_blockchain_node_engine_blockchain_nodes.all_locations", "blockchain_nodes.*", map[string]string{
+						"blockchain_type": "ETHEREUM",
+						"location":        "us-central1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceBlockchainNodeEngineBlockchainNodes_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_blockchain_node_engine_blockchain_nodes" "node" {
+  location           = "us-central1"
+  blockchain_type    = "ETHEREUM"
+  blockchain_node_id = "tf-test-node-%{random_suffix}"
+
+  ethereum_details {
+    node_type        = "FULL"
+    consensus_client = "LIGHTHOUSE"
+    execution_client = "GETH"
+    network          = "MAINNET"
+  }
+
+  labels = {
+    environment = "dev"
+  }
+}
+
+data "google_blockchain_node_engine_blockchain_nodes" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name   = "name"
+    values = ["/blockchainNodes/tf-test-node-%{random_suffix}$"]
+  }
+
+  filters {
+    name   = "state"
+    values = ["^RUNNING$"]
+  }
+
+  filters {
+    name   = "blockchain_type"
+    values = ["^ETHEREUM$"]
+  }
+
+  depends_on = [google_blockchain_node_engine_blockchain_nodes.node]
+}
+
+data "google_blockchain_node_engine_blockchain_nodes" "all_locations" {
+  filters {
+    name   = "name"
+    values = ["/blockchainNodes/tf-test-node-%{random_suffix}$"]
+  }
+
+  depends_on = [google_blockchain_node_engine_blockchain_nodes.node]
+}
+`, context)
+}
@@ -0,0 +1,147 @@
+package chronicle
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceChronicleDataAccessScopes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceChronicleDataAccessScopesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the data access scopes are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The location of the Chronicle instance, for example us.`,
+			},
+			"instance": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID of the Chronicle instance.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("display_name"),
+			"data_access_scopes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the data access scope.`,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The display name of the data access scope.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The description of the data access scope.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceChronicleDataAccessScopesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Chronicle data access scopes: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{ChronicleBasePath}}projects/{{project}}/locations/{{location}}/instances/{{instance}}/dataAccessScopes")
+	if err != nil {
+		return err
+	}
+
+	scopes := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing Chronicle data access scopes: %s", err)
+		}
+
+		if items, ok := res["dataAccessScopes"].([]interface{}); ok {
+			scopes = append(scopes, flattenChronicleDataAccessScopes(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("data_access_scopes", tpgresource.ApplyDatasourceFilters(filters, scopes)); err != nil {
+		return fmt.Errorf("Error setting Chronicle data access scopes: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/instances/%s/dataAccessScopes", project, d.Get("location").(string), d.Get("instance").(string)))
+
+	return nil
+}
+
+func flattenChronicleDataAccessScopes(items []interface{}) []map[string]interface{} {
+	scopes := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		scope, ok := item.(map[string]interface{})
+		if !ok || len(scope) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		scopes = append(scopes, map[string]interface{}{
+			"name":         scope["name"],
+			"display_name": scope["displayName"],
+			"description":  scope["description"],
+		})
+	}
+	return scopes
+}
@@ -0,0 +1,61 @@
+package chronicle_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccDataSourceChronicleDataAccessScopes_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"chronicle_id":  envvar.GetTestChronicleInstanceIdFromEnv(t),
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckChronicleDataAccessScopeDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceChronicleDataAccessScopes_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_chronicle_data_access_scopes.filtered", "data_access_scopes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_chronicle_data_access_scopes.filtered", "data_access_scopes.0.name", "google_chronicle_data_access_scope.scope", "name"),
+					resource.TestCheckResourceAttrPair("data.google_chronicle_data_access_scopes.filtered", "data_access_scopes.0.display_name", "google_chronicle_data_access_scope.scope", "display_name"),
+					resource.TestCheckResourceAttrPair("data.google_chronicle_data_access_scopes.filtered", "data_access_scopes.0.description", "google_chronicle_data_access_scope.scope", "description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceChronicleDataAccessScopes_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_chronicle_data_access_scope" "scope" {
+  location             = "us"
+  instance             = "%{chronicle_id}"
+  data_access_scope_id = "tf-test-scope-id%{random_suffix}"
+  description          = "tf-test-scope-description%{random_suffix}"
+
+  allowed_data_access_labels {
+    log_type = "GITHUB"
+  }
+}
+
+data "google_chronicle_data_access_scopes" "filtered" {
+  location = "us"
+  instance = "%{chronicle_id}"
+
+  filters {
+    name           = "display_name"
+    literal_values = [google_chronicle_data_access_scope.scope.display_name]
+  }
+}
+`, context)
+}
@@ -0,0 +1,189 @@
+package colab
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceColabRuntimeTemplates() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceColabRuntimeTemplatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the runtime templates are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the runtime templates. If it is not provided, runtime templates across all Vertex AI locations of the project are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("display_name"),
+			"runtime_templates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the runtime template.`,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The display name of the runtime template.`,
+						},
+						"machine_spec": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The machine configuration of the runtimes created from the template.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"machine_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The Compute Engine machine type of the runtime.`,
+									},
+									"accelerator_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The type of hardware accelerator used by the runtime, if any.`,
+									},
+									"accelerator_count": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: `The number of accelerators used by the runtime.`,
+									},
+								},
+							},
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The labels of the runtime template, including labels configured outside of Terraform.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceColabRuntimeTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Colab runtime templates: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	locations := []string{d.Get("location").(string)}
+	id := fmt.Sprintf("projects/%s/locations/%s/notebookRuntimeTemplates", project, locations[0])
+	if locations[0] == "" {
+		// Colab Enterprise is served from regional endpoints, which do not accept
+		// the "-" location wildcard, so every Vertex AI location is listed instead.
+		locations, err = transport_tpg.ListLocationIds(config, billingProject, fmt.Sprintf("%sprojects/%s/locations", config.VertexAIBasePath, project), userAgent, "locations", "locationId")
+		if err != nil {
+			return fmt.Errorf("Error listing Vertex AI locations: %s", err)
+		}
+		id = fmt.Sprintf("projects/%s/locations/-/notebookRuntimeTemplates", project)
+	}
+
+	items, err := transport_tpg.ListAcrossLocations(locations, func(location string) ([]interface{}, error) {
+		return listColabRuntimeTemplates(config, billingProject, project, location, userAgent)
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing Colab runtime templates: %s", err)
+	}
+	runtimeTemplates := flattenColabRuntimeTemplates(items)
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("runtime_templates", tpgresource.ApplyDatasourceFilters(filters, runtimeTemplates)); err != nil {
+		return fmt.Errorf("Error setting Colab runtime templates: %s", err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+// listColabRuntimeTemplates returns the runtime templates of a single location,
+// read from the regional endpoint of that location.
+func listColabRuntimeTemplates(config *transport_tpg.Config, billingProject, project, location, userAgent string) ([]interface{}, error) {
+	url := strings.ReplaceAll(config.ColabBasePath, "{{location}}", location) + fmt.Sprintf("projects/%s/locations/%s/notebookRuntimeTemplates", project, location)
+	return transport_tpg.ListAllPages(func(pageToken string) ([]interface{}, string, error) {
+		res, nextPageToken, err := transport_tpg.SendListRequest(config, billingProject, url, userAgent, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		items, _ := res["notebookRuntimeTemplates"].([]interface{})
+		return items, nextPageToken, nil
+	}, transport_tpg.SendRequestRetryOptions)
+}
+
+func flattenColabRuntimeTemplates(items []interface{}) []map[string]interface{} {
+	runtimeTemplates := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		runtimeTemplate, ok := item.(map[string]interface{})
+		if !ok || len(runtimeTemplate) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		runtimeTemplates = append(runtimeTemplates, map[string]interface{}{
+			"name":         runtimeTemplate["name"],
+			"display_name": runtimeTemplate["displayName"],
+			"machine_spec": flattenColabRuntimeTemplatesMachineSpec(runtimeTemplate),
+			"labels":       runtimeTemplate["labels"],
+		})
+	}
+	return runtimeTemplates
+}
+
+func flattenColabRuntimeTemplatesMachineSpec(v map[string]interface{}) interface{} {
+	obj, ok := v["machineSpec"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"machine_type":      obj["machineType"],
+			"accelerator_type":  obj["acceleratorType"],
+			"accelerator_count": flattenColabRuntimeTemplatesMachineSpecAcceleratorCount(obj),
+		},
+	}
+}
+
+func flattenColabRuntimeTemplatesMachineSpecAcceleratorCount(v map[string]interface{}) interface{} {
+	if n, ok := v["acceleratorCount"].(float64); ok {
+		return int(n)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package colab_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceColabRuntimeTemplates_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckColabRuntimeTemplateDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceColabRuntimeTemplates_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_colab_runtime_templates.filtered", "runtime_templates.#", "1"),
+					resource.TestCheckResourceAttrSet("data.google_colab_runtime_templates.filtered", "runtime_templates.0.name"),
+					resource.TestCheckResourceAttrPair("data.google_colab_runtime_templates.filtered", "runtime_templates.0.display_name", "google_colab_runtime_template.runtime-template", "display_name"),
+					resource.TestCheckResourceAttr("data.google_colab_runtime_templates.filtered", "runtime_templates.0.machine_spec.0.machine_type", "e2-standard-4"),
+					resource.TestCheckResourceAttr("data.google_colab_runtime_templates.filtered", "runtime_templates.0.labels.environment", "dev"),
+					resource.TestCheckResourceAttr("data.google_colab_runtime_templates.all_locations", "runtime_templates.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceColabRuntimeTemplates_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_colab_runtime_template" "runtime-template" {
+  name         = "tf-test-colab-runtime-template%{random_suffix}"
+  display_name = "tf-test-runtime-template%{random_suffix}"
+  location     = "us-central1"
+
+  machine_spec {
+    machine_type = "e2-standard-4"
+  }
+
+  network_spec {
+    enable_internet_access = true
+  }
+
+  labels = {
+    environment = "dev"
+  }
+}
+
+data "google_colab_runtime_templates" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name   = "display_name"
+    values = ["^tf-test-runtime-template%{random_suffix}$"]
+  }
+
+  depends_on = [google_colab_runtime_template.runtime-template]
+}
+
+data "google_colab_runtime_templates" "all_locations" {
+  filters {
+    name   = "display_name"
+    values = ["^tf-test-runtime-template%{random_suffix}$"]
+  }
+
+  depends_on = [google_colab_runtime_template.runtime-template]
+}
+`, context)
+}
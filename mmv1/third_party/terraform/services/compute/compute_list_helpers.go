@@ -0,0 +1,36 @@
+package compute
+
+import (
+	"sort"
+	"strings"
+)
+
+// computeListItems returns the items of a Compute list response. Aggregated
+// list responses group items by scope instead, keyed by names such as
+// "zones/us-central1-a" or "global", with each scope holding its items under
+// key. Only the scopes starting with scopePrefix are kept, in the order of
+// their names.
+func computeListItems(res map[string]interface{}, scopePrefix, key string) []interface{} {
+	switch items := res["items"].(type) {
+	case []interface{}:
+		return items
+	case map[string]interface{}:
+		scopes := make([]string, 0, len(items))
+		for scope := range items {
+			if strings.HasPrefix(scope, scopePrefix) {
+				scopes = append(scopes, scope)
+			}
+		}
+		sort.Strings(scopes)
+
+		var scopedItems []interface{}
+		for _, scope := range scopes {
+			scoped, _ := items[scope].(map[string]interface{})
+			if v, ok := scoped[key].([]interface{}); ok {
+				scopedItems = append(scopedItems, v...)
+			}
+		}
+		return scopedItems
+	}
+	return nil
+}
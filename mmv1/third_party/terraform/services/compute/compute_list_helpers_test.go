@@ -0,0 +1,50 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestComputeListItems(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		Res    map[string]interface{}
+		Expect []interface{}
+	}{
+		"list": {
+			Res: map[string]interface{}{
+				"items": []interface{}{"a", "b"},
+			},
+			Expect: []interface{}{"a", "b"},
+		},
+		"aggregated list": {
+			Res: map[string]interface{}{
+				"items": map[string]interface{}{
+					"zones/us-east1-b": map[string]interface{}{
+						"instantSnapshots": []interface{}{"c"},
+					},
+					"zones/us-central1-a": map[string]interface{}{
+						"instantSnapshots": []interface{}{"a", "b"},
+					},
+					"zones/us-central1-f": map[string]interface{}{
+						"warning": map[string]interface{}{"code": "NO_RESULTS_ON_PAGE"},
+					},
+					"global": map[string]interface{}{
+						"instantSnapshots": []interface{}{"global"},
+					},
+				},
+			},
+			Expect: []interface{}{"a", "b", "c"},
+		},
+		"empty": {
+			Res:    map[string]interface{}{},
+			Expect: nil,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := computeListItems(tc.Res, "zones/", "instantSnapshots"); !reflect.DeepEqual(got, tc.Expect) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.Expect, got)
+		}
+	}
+}
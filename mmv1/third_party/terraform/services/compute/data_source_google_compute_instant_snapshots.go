@@ -0,0 +1,161 @@
+package compute
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceGoogleComputeInstantSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeInstantSnapshotsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the instant snapshots are located. If it is not provided, the provider project is used.`,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The zone of the instant snapshots. If it is not provided, instant snapshots across all zones are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "status"),
+			"instant_snapshots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the instant snapshot.`,
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The zone of the instant snapshot.`,
+						},
+						"source_disk": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The URI of the disk the instant snapshot was created from.`,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The status of the instant snapshot, for example READY or CREATING.`,
+						},
+						"self_link": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The URI of the instant snapshot.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeInstantSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for instant snapshots: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	// Without a zone, the aggregated list returns the instant snapshots of every zone.
+	path := "projects/{{project}}/aggregated/instantSnapshots"
+	id := fmt.Sprintf("projects/%s/aggregated/instantSnapshots", project)
+	if v, ok := d.GetOk("zone"); ok {
+		path = "projects/{{project}}/zones/{{zone}}/instantSnapshots"
+		id = fmt.Sprintf("projects/%s/zones/%s/instantSnapshots", project, v.(string))
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}"+path)
+	if err != nil {
+		return err
+	}
+
+	snapshots := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing instant snapshots: %s", err)
+		}
+
+		snapshots = append(snapshots, flattenGoogleComputeInstantSnapshots(computeListItems(res, "zones/", "instantSnapshots"))...)
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("instant_snapshots", tpgresource.ApplyDatasourceFilters(filters, snapshots)); err != nil {
+		return fmt.Errorf("Error setting instant snapshots: %s", err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+func flattenGoogleComputeInstantSnapshots(items []interface{}) []map[string]interface{} {
+	snapshots := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		snapshot, ok := item.(map[string]interface{})
+		if !ok || len(snapshot) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		zone, _ := snapshot["zone"].(string)
+		snapshots = append(snapshots, map[string]interface{}{
+			"name":        snapshot["name"],
+			"zone":        tpgresource.GetResourceNameFromSelfLink(zone),
+			"source_disk": snapshot["sourceDisk"],
+			"status":      snapshot["status"],
+			"self_link":   snapshot["selfLink"],
+		})
+	}
+	return snapshots
+}
@@ -0,0 +1,100 @@
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceGoogleComputeInstantSnapshots_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckComputeInstantSnapshotDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeInstantSnapshots_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_instant_snapshots.filtered", "instant_snapshots.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_compute_instant_snapshots.filtered", "instant_snapshots.0.name", "google_compute_instant_snapshot.first", "name"),
+					resource.TestCheckResourceAttr("data.google_compute_instant_snapshots.filtered", "instant_snapshots.0.zone", "us-central1-a"),
+					resource.TestCheckResourceAttrPair("data.google_compute_instant_snapshots.filtered", "instant_snapshots.0.source_disk", "google_compute_disk.first", "self_link"),
+					resource.TestCheckResourceAttr("data.google_compute_instant_snapshots.filtered", "instant_snapshots.0.status", "READY"),
+					resource.TestCheckResourceAttrPair("data.google_compute_instant_snapshots.filtered", "instant_snapshots.0.self_link", "google_compute_instant_snapshot.first", "self_link"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_compute_instant_snapshots.all_zones", "instant_snapshots.*", map[string]string{
+						"zone":   "us-east1-b",
+						"status": "READY",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeInstantSnapshots_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_compute_disk" "first" {
+  name = "tf-test-disk-first-%{random_suffix}"
+  type = "pd-ssd"
+  zone = "us-central1-a"
+  size = 10
+}
+
+resource "google_compute_disk" "second" {
+  name = "tf-test-disk-second-%{random_suffix}"
+  type = "pd-ssd"
+  zone = "us-east1-b"
+  size = 10
+}
+
+resource "google_compute_instant_snapshot" "first" {
+  name        = "tf-test-first-%{random_suffix}"
+  zone        = "us-central1-a"
+  source_disk = google_compute_disk.first.self_link
+}
+
+resource "google_compute_instant_snapshot" "second" {
+  name        = "tf-test-second-%{random_suffix}"
+  zone        = "us-east1-b"
+  source_disk = google_compute_disk.second.self_link
+}
+
+data "google_compute_instant_snapshots" "filtered" {
+  zone = "us-central1-a"
+
+  filters {
+    name   = "name"
+    values = ["^tf-test-first-%{random_suffix}$"]
+  }
+
+  filters {
+    name   = "status"
+    values = ["^READY$"]
+  }
+
+  depends_on = [
+    google_compute_instant_snapshot.first,
+    google_compute_instant_snapshot.second,
+  ]
+}
+
+data "google_compute_instant_snapshots" "all_zones" {
+  filters {
+    name   = "name"
+    values = ["-%{random_suffix}$"]
+  }
+
+  depends_on = [
+    google_compute_instant_snapshot.first,
+    google_compute_instant_snapshot.second,
+  ]
+}
+`, context)
+}
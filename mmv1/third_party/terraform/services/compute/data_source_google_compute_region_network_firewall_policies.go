@@ -0,0 +1,168 @@
+package compute
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceGoogleComputeRegionNetworkFirewallPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeRegionNetworkFirewallPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the firewall policies are located. If it is not provided, the provider project is used.`,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The region of the firewall policies. If it is not provided, firewall policies across all regions are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name"),
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the firewall policy.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The description of the firewall policy.`,
+						},
+						"rule_tuple_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The total count of all firewall policy rule tuples, a measure of the complexity of the rules of the policy.`,
+						},
+						"self_link": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The URI of the firewall policy.`,
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The region of the firewall policy.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeRegionNetworkFirewallPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for regional network firewall policies: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	// Without a region, the aggregated list returns the policies of every scope.
+	path := "projects/{{project}}/aggregated/firewallPolicies"
+	id := fmt.Sprintf("projects/%s/aggregated/firewallPolicies", project)
+	if v, ok := d.GetOk("region"); ok {
+		path = "projects/{{project}}/regions/{{region}}/firewallPolicies"
+		id = fmt.Sprintf("projects/%s/regions/%s/firewallPolicies", project, v.(string))
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}"+path)
+	if err != nil {
+		return err
+	}
+
+	policies := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing regional network firewall policies: %s", err)
+		}
+
+		policies = append(policies, flattenGoogleComputeRegionNetworkFirewallPolicies(computeListItems(res, "regions/", "firewallPolicies"))...)
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("policies", tpgresource.ApplyDatasourceFilters(filters, policies)); err != nil {
+		return fmt.Errorf("Error setting regional network firewall policies: %s", err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+func flattenGoogleComputeRegionNetworkFirewallPolicies(items []interface{}) []map[string]interface{} {
+	policies := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		policy, ok := item.(map[string]interface{})
+		if !ok || len(policy) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		region, _ := policy["region"].(string)
+		policies = append(policies, map[string]interface{}{
+			"name":             policy["name"],
+			"description":      policy["description"],
+			"rule_tuple_count": flattenGoogleComputeRegionNetworkFirewallPoliciesRuleTupleCount(policy),
+			"self_link":        policy["selfLink"],
+			"region":           tpgresource.GetResourceNameFromSelfLink(region),
+		})
+	}
+	return policies
+}
+
+func flattenGoogleComputeRegionNetworkFirewallPoliciesRuleTupleCount(v map[string]interface{}) interface{} {
+	if n, ok := v["ruleTupleCount"].(float64); ok {
+		return int(n)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceGoogleComputeRegionNetworkFirewallPolicies_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckComputeRegionNetworkFirewallPolicyDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeRegionNetworkFirewallPolicies_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_region_network_firewall_policies.filtered", "policies.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_compute_region_network_firewall_policies.filtered", "policies.0.name", "google_compute_region_network_firewall_policy.first", "name"),
+					resource.TestCheckResourceAttr("data.google_compute_region_network_firewall_policies.filtered", "policies.0.description", "First policy"),
+					resource.TestCheckResourceAttrPair("data.google_compute_region_network_firewall_policies.filtered", "policies.0.rule_tuple_count", "google_compute_region_network_firewall_policy.first", "rule_tuple_count"),
+					resource.TestCheckResourceAttrPair("data.google_compute_region_network_firewall_policies.filtered", "policies.0.self_link", "google_compute_region_network_firewall_policy.first", "self_link"),
+					resource.TestCheckResourceAttr("data.google_compute_region_network_firewall_policies.filtered", "policies.0.region", "us-central1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_compute_region_network_firewall_policies.all_regions", "policies.*", map[string]string{
+						"description": "Second policy",
+						"region":      "us-east1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeRegionNetworkFirewallPolicies_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_compute_region_network_firewall_policy" "first" {
+  name        = "tf-test-first-%{random_suffix}"
+  region      = "us-central1"
+  description = "First policy"
+}
+
+resource "google_compute_region_network_firewall_policy" "second" {
+  name        = "tf-test-second-%{random_suffix}"
+  region      = "us-east1"
+  description = "Second policy"
+}
+
+data "google_compute_region_network_firewall_policies" "filtered" {
+  region = "us-central1"
+
+  filters {
+    name   = "name"
+    values = ["^tf-test-first-%{random_suffix}$"]
+  }
+
+  depends_on = [
+    google_compute_region_network_firewall_policy.first,
+    google_compute_region_network_firewall_policy.second,
+  ]
+}
+
+data "google_compute_region_network_firewall_policies" "all_regions" {
+  filters {
+    name   = "name"
+    values = ["-%{random_suffix}$"]
+  }
+
+  depends_on = [
+    google_compute_region_network_firewall_policy.first,
+    google_compute_region_network_firewall_policy.second,
+  ]
+}
+`, context)
+}
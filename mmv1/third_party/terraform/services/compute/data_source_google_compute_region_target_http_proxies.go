@@ -0,0 +1,156 @@
+package compute
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceGoogleComputeRegionTargetHttpProxies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeRegionTargetHttpProxiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the target HTTP proxies are located. If it is not provided, the provider project is used.`,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The region of the target HTTP proxies. If it is not provided, target HTTP proxies across all regions are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name"),
+			"proxies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the target HTTP proxy.`,
+						},
+						"url_map": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The URI of the region URL map that routes the requests of the target HTTP proxy.`,
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The region of the target HTTP proxy.`,
+						},
+						"self_link": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The URI of the target HTTP proxy.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeRegionTargetHttpProxiesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for regional target HTTP proxies: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	// Without a region, the aggregated list returns the proxies of every scope,
+	// of which only the regional ones are kept.
+	path := "projects/{{project}}/aggregated/targetHttpProxies"
+	id := fmt.Sprintf("projects/%s/aggregated/targetHttpProxies", project)
+	if v, ok := d.GetOk("region"); ok {
+		path = "projects/{{project}}/regions/{{region}}/targetHttpProxies"
+		id = fmt.Sprintf("projects/%s/regions/%s/targetHttpProxies", project, v.(string))
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}"+path)
+	if err != nil {
+		return err
+	}
+
+	proxies := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing regional target HTTP proxies: %s", err)
+		}
+
+		proxies = append(proxies, flattenGoogleComputeRegionTargetHttpProxies(computeListItems(res, "regions/", "targetHttpProxies"))...)
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("proxies", tpgresource.ApplyDatasourceFilters(filters, proxies)); err != nil {
+		return fmt.Errorf("Error setting regional target HTTP proxies: %s", err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+func flattenGoogleComputeRegionTargetHttpProxies(items []interface{}) []map[string]interface{} {
+	proxies := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		proxy, ok := item.(map[string]interface{})
+		if !ok || len(proxy) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		region, _ := proxy["region"].(string)
+		proxies = append(proxies, map[string]interface{}{
+			"name":      proxy["name"],
+			"url_map":   proxy["urlMap"],
+			"region":    tpgresource.GetResourceNameFromSelfLink(region),
+			"self_link": proxy["selfLink"],
+		})
+	}
+	return proxies
+}
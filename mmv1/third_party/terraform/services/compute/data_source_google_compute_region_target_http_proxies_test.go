@@ -0,0 +1,89 @@
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceGoogleComputeRegionTargetHttpProxies_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckComputeRegionTargetHttpProxyDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeRegionTargetHttpProxies_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_region_target_http_proxies.filtered", "proxies.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_compute_region_target_http_proxies.filtered", "proxies.0.name", "google_compute_region_target_http_proxy.proxy", "name"),
+					resource.TestCheckResourceAttrPair("data.google_compute_region_target_http_proxies.filtered", "proxies.0.url_map", "google_compute_region_url_map.url_map", "self_link"),
+					resource.TestCheckResourceAttr("data.google_compute_region_target_http_proxies.filtered", "proxies.0.region", "us-central1"),
+					resource.TestCheckResourceAttrPair("data.google_compute_region_target_http_proxies.filtered", "proxies.0.self_link", "google_compute_region_target_http_proxy.proxy", "self_link"),
+					resource.TestCheckResourceAttr("data.google_compute_region_target_http_proxies.all_regions", "proxies.#", "1"),
+					resource.TestCheckResourceAttr("data.google_compute_region_target_http_proxies.all_regions", "proxies.0.region", "us-central1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeRegionTargetHttpProxies_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_compute_region_health_check" "health_check" {
+  name   = "tf-test-health-check-%{random_suffix}"
+  region = "us-central1"
+
+  http_health_check {
+    port = 80
+  }
+}
+
+resource "google_compute_region_backend_service" "backend_service" {
+  name                  = "tf-test-backend-service-%{random_suffix}"
+  region                = "us-central1"
+  protocol              = "HTTP"
+  load_balancing_scheme = "INTERNAL_MANAGED"
+  health_checks         = [google_compute_region_health_check.health_check.self_link]
+}
+
+resource "google_compute_region_url_map" "url_map" {
+  name            = "tf-test-url-map-%{random_suffix}"
+  region          = "us-central1"
+  default_service = google_compute_region_backend_service.backend_service.self_link
+}
+
+resource "google_compute_region_target_http_proxy" "proxy" {
+  name    = "tf-test-proxy-%{random_suffix}"
+  region  = "us-central1"
+  url_map = google_compute_region_url_map.url_map.self_link
+}
+
+data "google_compute_region_target_http_proxies" "filtered" {
+  region = "us-central1"
+
+  filters {
+    name   = "name"
+    values = ["^tf-test-proxy-%{random_suffix}$"]
+  }
+
+  depends_on = [google_compute_region_target_http_proxy.proxy]
+}
+
+data "google_compute_region_target_http_proxies" "all_regions" {
+  filters {
+    name   = "name"
+    values = ["^tf-test-proxy-%{random_suffix}$"]
+  }
+
+  depends_on = [google_compute_region_target_http_proxy.proxy]
+}
+`, context)
+}
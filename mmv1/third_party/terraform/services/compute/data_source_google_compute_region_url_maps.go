@@ -0,0 +1,140 @@
+package compute
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceGoogleComputeRegionUrlMaps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeRegionUrlMapsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the URL maps are located. If it is not provided, the provider project is used.`,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The region of the URL maps. If it is not provided, URL maps across all regions are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "region"),
+			"url_maps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the URL map.`,
+						},
+						"default_service": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The URI of the region backend service requests are routed to when no host rule matches.`,
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The region of the URL map.`,
+						},
+						"self_link": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The URI of the URL map.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeRegionUrlMapsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for regional URL maps: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	// Without a region, the aggregated list returns the URL maps of every
+	// scope, of which only the regional ones are kept.
+	path := "projects/{{project}}/aggregated/urlMaps"
+	id := fmt.Sprintf("projects/%s/aggregated/urlMaps", project)
+	if v, ok := d.GetOk("region"); ok {
+		path = "projects/{{project}}/regions/{{region}}/urlMaps"
+		id = fmt.Sprintf("projects/%s/regions/%s/urlMaps", project, v.(string))
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{ComputeBasePath}}"+path)
+	if err != nil {
+		return err
+	}
+
+	items, err := transport_tpg.ListAllPages(func(pageToken string) ([]interface{}, string, error) {
+		res, nextPageToken, err := transport_tpg.SendListRequest(config, billingProject, url, userAgent, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		return computeListItems(res, "regions/", "urlMaps"), nextPageToken, nil
+	}, transport_tpg.SendRequestRetryOptions)
+	if err != nil {
+		return fmt.Errorf("Error listing regional URL maps: %s", err)
+	}
+	urlMaps := flattenGoogleComputeRegionUrlMaps(items)
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("url_maps", tpgresource.ApplyDatasourceFilters(filters, urlMaps)); err != nil {
+		return fmt.Errorf("Error setting regional URL maps: %s", err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+func flattenGoogleComputeRegionUrlMaps(items []interface{}) []map[string]interface{} {
+	urlMaps := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		urlMap, ok := item.(map[string]interface{})
+		if !ok || len(urlMap) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		region, _ := urlMap["region"].(string)
+		urlMaps = append(urlMaps, map[string]interface{}{
+			"name":            urlMap["name"],
+			"default_service": urlMap["defaultService"],
+			"region":          tpgresource.GetResourceNameFromSelfLink(region),
+			"self_link":       urlMap["selfLink"],
+		})
+	}
+	return urlMaps
+}
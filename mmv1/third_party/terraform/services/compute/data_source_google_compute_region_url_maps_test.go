@@ -0,0 +1,98 @@
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceGoogleComputeRegionUrlMaps_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckComputeRegionUrlMapDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleComputeRegionUrlMaps_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_region_url_maps.filtered", "url_maps.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_compute_region_url_maps.filtered", "url_maps.0.name", "google_compute_region_url_map.url_map", "name"),
+					resource.TestCheckResourceAttrPair("data.google_compute_region_url_maps.filtered", "url_maps.0.default_service", "google_compute_region_backend_service.backend_service", "self_link"),
+					resource.TestCheckResourceAttr("data.google_compute_region_url_maps.filtered", "url_maps.0.region", "us-central1"),
+					resource.TestCheckResourceAttrPair("data.google_compute_region_url_maps.filtered", "url_maps.0.self_link", "google_compute_region_url_map.url_map", "self_link"),
+					resource.TestCheckResourceAttr("data.google_compute_region_url_maps.all_regions", "url_maps.#", "1"),
+					resource.TestCheckResourceAttr("data.google_compute_region_url_maps.all_regions", "url_maps.0.region", "us-central1"),
+					resource.TestCheckResourceAttr("data.google_compute_region_url_maps.other_region", "url_maps.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleComputeRegionUrlMaps_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_compute_region_health_check" "health_check" {
+  name   = "tf-test-health-check-%{random_suffix}"
+  region = "us-central1"
+
+  http_health_check {
+    port = 80
+  }
+}
+
+resource "google_compute_region_backend_service" "backend_service" {
+  name                  = "tf-test-backend-service-%{random_suffix}"
+  region                = "us-central1"
+  protocol              = "HTTP"
+  load_balancing_scheme = "INTERNAL_MANAGED"
+  health_checks         = [google_compute_region_health_check.health_check.self_link]
+}
+
+resource "google_compute_region_url_map" "url_map" {
+  name            = "tf-test-url-map-%{random_suffix}"
+  region          = "us-central1"
+  default_service = google_compute_region_backend_service.backend_service.self_link
+}
+
+data "google_compute_region_url_maps" "filtered" {
+  region = "us-central1"
+
+  filters {
+    name   = "name"
+    values = ["^tf-test-url-map-%{random_suffix}$"]
+  }
+
+  depends_on = [google_compute_region_url_map.url_map]
+}
+
+data "google_compute_region_url_maps" "all_regions" {
+  filters {
+    name   = "name"
+    values = ["^tf-test-url-map-%{random_suffix}$"]
+  }
+
+  depends_on = [google_compute_region_url_map.url_map]
+}
+
+data "google_compute_region_url_maps" "other_region" {
+  filters {
+    name   = "name"
+    values = ["^tf-test-url-map-%{random_suffix}$"]
+  }
+
+  filters {
+    name   = "region"
+    values = ["^europe-"]
+  }
+
+  depends_on = [google_compute_region_url_map.url_map]
+}
+`, context)
+}
@@ -0,0 +1,168 @@
+package containeraws
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceGoogleContainerAwsNodePools() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleContainerAwsNodePoolsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The full resource name of the AWS cluster, in the format projects/{project}/locations/{location}/awsClusters/{cluster}.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The location of the AWS cluster, taken from cluster.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "state"),
+			"node_pools": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the node pool.`,
+						},
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The Kubernetes version of the nodes of the node pool.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The state of the node pool, for example PROVISIONING or RUNNING.`,
+						},
+						"config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The configuration of the nodes of the node pool.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The AWS EC2 instance type of the nodes.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleContainerAwsNodePoolsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	// The multi-cloud API is served from a regional endpoint, so the location
+	// of the cluster must be known before the URL can be built.
+	cluster := d.Get("cluster").(string)
+	if err := d.Set("location", tpgresource.GetRegionFromRegionalSelfLink(cluster)); err != nil {
+		return fmt.Errorf("Error setting location: %s", err)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{ContainerAwsBasePath}}{{cluster}}/awsNodePools")
+	if err != nil {
+		return err
+	}
+
+	nodePools := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing AWS node pools: %s", err)
+		}
+
+		if items, ok := res["awsNodePools"].([]interface{}); ok {
+			nodePools = append(nodePools, flattenGoogleContainerAwsNodePools(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("node_pools", tpgresource.ApplyDatasourceFilters(filters, nodePools)); err != nil {
+		return fmt.Errorf("Error setting AWS node pools: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/awsNodePools", cluster))
+
+	return nil
+}
+
+func flattenGoogleContainerAwsNodePools(items []interface{}) []map[string]interface{} {
+	nodePools := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		nodePool, ok := item.(map[string]interface{})
+		if !ok || len(nodePool) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		nodePools = append(nodePools, map[string]interface{}{
+			"name":    nodePool["name"],
+			"version": nodePool["version"],
+			"state":   nodePool["state"],
+			"config":  flattenGoogleContainerAwsNodePoolsConfig(nodePool),
+		})
+	}
+	return nodePools
+}
+
+func flattenGoogleContainerAwsNodePoolsConfig(v map[string]interface{}) interface{} {
+	obj, ok := v["config"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"instance_type": obj["instanceType"],
+		},
+	}
+}
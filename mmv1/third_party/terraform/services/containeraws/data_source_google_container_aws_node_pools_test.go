@@ -0,0 +1,190 @@
+package containeraws_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccDataSourceGoogleContainerAwsNodePools_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"aws_acct_id":    "111111111111",
+		"aws_db_key":     "00000000-0000-0000-0000-17aad2f0f61f",
+		"aws_region":     "us-west-2",
+		"aws_sg":         "sg-0b3f63cb91b247628",
+		"aws_subnet":     "subnet-0b3f63cb91b247628",
+		"aws_vol_key":    "00000000-0000-0000-0000-17aad2f0f61f",
+		"aws_vpc":        "vpc-0b3f63cb91b247628",
+		"byo_prefix":     "mmv2",
+		"project_name":   envvar.GetTestProjectFromEnv(),
+		"project_number": envvar.GetTestProjectNumberFromEnv(),
+		"service_acct":   envvar.GetTestServiceAccountFromEnv(t),
+		"random_suffix":  acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckContainerAwsNodePoolDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleContainerAwsNodePools_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_container_aws_node_pools.filtered", "node_pools.#", "1"),
+					resource.TestCheckResourceAttr("data.google_container_aws_node_pools.filtered", "location", "us-west1"),
+					resource.TestCheckResourceAttrPair("data.google_container_aws_node_pools.filtered", "node_pools.0.version", "google_container_aws_node_pool.first", "version"),
+					resource.TestCheckResourceAttr("data.google_container_aws_node_pools.filtered", "node_pools.0.state", "RUNNING"),
+					resource.TestCheckResourceAttr("data.google_container_aws_node_pools.filtered", "node_pools.0.config.0.instance_type", "t3.medium"),
+					resource.TestCheckResourceAttr("data.google_container_aws_node_pools.all", "node_pools.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_container_aws_node_pools.all", "node_pools.*", map[string]string{
+						"config.0.instance_type": "t3.large",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleContainerAwsNodePools_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+data "google_container_aws_versions" "versions" {
+  project  = "%{project_name}"
+  location = "us-west1"
+}
+
+resource "google_container_aws_cluster" "primary" {
+  authorization {
+    admin_users {
+      username = "%{service_acct}"
+    }
+  }
+
+  aws_region = "%{aws_region}"
+
+  control_plane {
+    aws_services_authentication {
+      role_arn          = "arn:aws:iam::%{aws_acct_id}:role/%{byo_prefix}-1p-dev-oneplatform"
+      role_session_name = "%{byo_prefix}-1p-dev-session"
+    }
+
+    config_encryption {
+      kms_key_arn = "arn:aws:kms:%{aws_region}:%{aws_acct_id}:key/%{aws_db_key}"
+    }
+
+    database_encryption {
+      kms_key_arn = "arn:aws:kms:%{aws_region}:%{aws_acct_id}:key/%{aws_db_key}"
+    }
+
+    iam_instance_profile = "%{byo_prefix}-1p-dev-controlplane"
+    subnet_ids           = ["%{aws_subnet}"]
+    version              = "${data.google_container_aws_versions.versions.valid_versions[0]}"
+    instance_type        = "t3.medium"
+  }
+
+  fleet {
+    project = "%{project_number}"
+  }
+
+  location = "us-west1"
+  name     = "tf-test-name%{random_suffix}"
+
+  networking {
+    pod_address_cidr_blocks     = ["10.2.0.0/16"]
+    service_address_cidr_blocks = ["10.1.0.0/16"]
+    vpc_id                      = "%{aws_vpc}"
+  }
+
+  project = "%{project_name}"
+}
+
+resource "google_container_aws_node_pool" "first" {
+  autoscaling {
+    max_node_count = 5
+    min_node_count = 1
+  }
+
+  cluster = google_container_aws_cluster.primary.name
+
+  config {
+    config_encryption {
+      kms_key_arn = "arn:aws:kms:%{aws_region}:%{aws_acct_id}:key/%{aws_db_key}"
+    }
+
+    iam_instance_profile = "%{byo_prefix}-1p-dev-nodepool"
+    instance_type        = "t3.medium"
+  }
+
+  location = "us-west1"
+
+  max_pods_constraint {
+    max_pods_per_node = 110
+  }
+
+  name      = "tf-test-first-%{random_suffix}"
+  subnet_id = "%{aws_subnet}"
+  version   = "${data.google_container_aws_versions.versions.valid_versions[0]}"
+  project   = "%{project_name}"
+}
+
+resource "google_container_aws_node_pool" "second" {
+  autoscaling {
+    max_node_count = 5
+    min_node_count = 1
+  }
+
+  cluster = google_container_aws_cluster.primary.name
+
+  config {
+    config_encryption {
+      kms_key_arn = "arn:aws:kms:%{aws_region}:%{aws_acct_id}:key/%{aws_db_key}"
+    }
+
+    iam_instance_profile = "%{byo_prefix}-1p-dev-nodepool"
+    instance_type        = "t3.large"
+  }
+
+  location = "us-west1"
+
+  max_pods_constraint {
+    max_pods_per_node = 110
+  }
+
+  name      = "tf-test-second-%{random_suffix}"
+  subnet_id = "%{aws_subnet}"
+  version   = "${data.google_container_aws_versions.versions.valid_versions[0]}"
+  project   = "%{project_name}"
+}
+
+data "google_container_aws_node_pools" "filtered" {
+  cluster = google_container_aws_cluster.primary.id
+
+  filters {
+    name   = "name"
+    values = ["/awsNodePools/tf-test-first-%{random_suffix}$"]
+  }
+
+  filters {
+    name   = "state"
+    values = ["^RUNNING$"]
+  }
+
+  depends_on = [
+    google_container_aws_node_pool.first,
+    google_container_aws_node_pool.second,
+  ]
+}
+
+data "google_container_aws_node_pools" "all" {
+  cluster = google_container_aws_cluster.primary.id
+
+  depends_on = [
+    google_container_aws_node_pool.first,
+    google_container_aws_node_pool.second,
+  ]
+}
+`, context)
+}
@@ -0,0 +1,182 @@
+package dataproc
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDataprocAutoscalingPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDataprocAutoscalingPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the autoscaling policies are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the autoscaling policies, either global or a region. If it is not provided, autoscaling policies across global and every region of the project are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("policy_id"),
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the autoscaling policy.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the autoscaling policy.`,
+						},
+						"basic_algorithm": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `A summary of the basic autoscaling algorithm of the policy.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cooldown_period": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The duration between scaling events.`,
+									},
+									"graceful_decommission_timeout": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The timeout for YARN graceful decommissioning of Node Managers.`,
+									},
+									"scale_up_factor": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: `The fraction of average pending memory in the last cooldown period for which to add workers.`,
+									},
+									"scale_down_factor": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: `The fraction of average pending memory in the last cooldown period for which to remove workers.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDataprocAutoscalingPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Dataproc autoscaling policies: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	locations := []string{d.Get("location").(string)}
+	id := fmt.Sprintf("projects/%s/locations/%s/autoscalingPolicies", project, locations[0])
+	if locations[0] == "" {
+		// Dataproc does not accept the "-" location wildcard, so global and
+		// every Compute Engine region of the project are listed instead.
+		regions, err := transport_tpg.ListLocationIds(config, billingProject, fmt.Sprintf("%sprojects/%s/regions", config.ComputeBasePath, project), userAgent, "items", "name")
+		if err != nil {
+			return fmt.Errorf("Error listing regions: %s", err)
+		}
+		locations = append([]string{"global"}, regions...)
+		id = fmt.Sprintf("projects/%s/locations/-/autoscalingPolicies", project)
+	}
+
+	items, err := transport_tpg.ListAcrossLocations(locations, func(location string) ([]interface{}, error) {
+		return listDataprocAutoscalingPolicies(config, billingProject, project, location, userAgent)
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing Dataproc autoscaling policies: %s", err)
+	}
+	policies := flattenDataprocAutoscalingPolicies(items)
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("policies", tpgresource.ApplyDatasourceFilters(filters, policies)); err != nil {
+		return fmt.Errorf("Error setting Dataproc autoscaling policies: %s", err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+// listDataprocAutoscalingPolicies returns the autoscaling policies of a single
+// location.
+func listDataprocAutoscalingPolicies(config *transport_tpg.Config, billingProject, project, location, userAgent string) ([]interface{}, error) {
+	url := fmt.Sprintf("%sprojects/%s/locations/%s/autoscalingPolicies", config.DataprocBasePath, project, location)
+	return transport_tpg.ListAllPages(func(pageToken string) ([]interface{}, string, error) {
+		res, nextPageToken, err := transport_tpg.SendListRequest(config, billingProject, url, userAgent, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		items, _ := res["policies"].([]interface{})
+		return items, nextPageToken, nil
+	}, transport_tpg.SendRequestRetryOptions)
+}
+
+func flattenDataprocAutoscalingPolicies(items []interface{}) []map[string]interface{} {
+	policies := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		policy, ok := item.(map[string]interface{})
+		if !ok || len(policy) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		policies = append(policies, map[string]interface{}{
+			"policy_id":       policy["id"],
+			"name":            policy["name"],
+			"basic_algorithm": flattenDataprocAutoscalingPoliciesBasicAlgorithm(policy),
+		})
+	}
+	return policies
+}
+
+func flattenDataprocAutoscalingPoliciesBasicAlgorithm(v map[string]interface{}) interface{} {
+	obj, ok := v["basicAlgorithm"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	yarnConfig, _ := obj["yarnConfig"].(map[string]interface{})
+	return []interface{}{
+		map[string]interface{}{
+			"cooldown_period":               obj["cooldownPeriod"],
+			"graceful_decommission_timeout": yarnConfig["gracefulDecommissionTimeout"],
+			"scale_up_factor":               yarnConfig["scaleUpFactor"],
+			"scale_down_factor":             yarnConfig["scaleDownFactor"],
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package dataproc_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceDataprocAutoscalingPolicies_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckDataprocAutoscalingPolicyDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDataprocAutoscalingPolicies_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_dataproc_autoscaling_policies.filtered", "policies.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_dataproc_autoscaling_policies.filtered", "policies.0.policy_id", "google_dataproc_autoscaling_policy.policy", "policy_id"),
+					resource.TestCheckResourceAttrPair("data.google_dataproc_autoscaling_policies.filtered", "policies.0.name", "google_dataproc_autoscaling_policy.policy", "name"),
+					resource.TestCheckResourceAttr("data.google_dataproc_autoscaling_policies.filtered", "policies.0.basic_algorithm.0.cooldown_period", "300s"),
+					resource.TestCheckResourceAttr("data.google_dataproc_autoscaling_policies.filtered", "policies.0.basic_algorithm.0.graceful_decommission_timeout", "30s"),
+					resource.TestCheckResourceAttr("data.google_dataproc_autoscaling_policies.filtered", "policies.0.basic_algorithm.0.scale_up_factor", "0.5"),
+					resource.TestCheckResourceAttr("data.google_dataproc_autoscaling_policies.filtered", "policies.0.basic_algorithm.0.scale_down_factor", "0.25"),
+					resource.TestCheckResourceAttr("data.google_dataproc_autoscaling_policies.all_locations", "policies.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDataprocAutoscalingPolicies_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_dataproc_autoscaling_policy" "policy" {
+  policy_id = "tf-test-dataproc-policy-%{random_suffix}"
+  location  = "us-central1"
+
+  worker_config {
+    max_instances = 3
+  }
+
+  basic_algorithm {
+    cooldown_period = "300s"
+
+    yarn_config {
+      graceful_decommission_timeout = "30s"
+      scale_up_factor               = 0.5
+      scale_down_factor             = 0.25
+    }
+  }
+}
+
+data "google_dataproc_autoscaling_policies" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name   = "policy_id"
+    values = ["^tf-test-dataproc-policy-%{random_suffix}$"]
+  }
+
+  depends_on = [google_dataproc_autoscaling_policy.policy]
+}
+
+data "google_dataproc_autoscaling_policies" "all_locations" {
+  filters {
+    name   = "policy_id"
+    values = ["^tf-test-dataproc-policy-%{random_suffix}$"]
+  }
+
+  depends_on = [google_dataproc_autoscaling_policy.policy]
+}
+`, context)
+}
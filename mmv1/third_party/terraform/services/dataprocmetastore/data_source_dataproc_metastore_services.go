@@ -0,0 +1,160 @@
+package dataprocmetastore
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDataprocMetastoreServices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDataprocMetastoreServicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the services are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the services. If it is not provided, services across all locations are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "state", "tier"),
+			"services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the service.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The state of the service, for example CREATING or ACTIVE.`,
+						},
+						"tier": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The tier of the service, for example DEVELOPER or ENTERPRISE.`,
+						},
+						"release_channel": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The release channel of the service, either CANARY or STABLE.`,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The labels of the service, including labels configured outside of Terraform.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDataprocMetastoreServicesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Dataproc Metastore services: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	location := "-"
+	if v, ok := d.GetOk("location"); ok {
+		location = v.(string)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{DataprocMetastoreBasePath}}projects/{{project}}/locations/%s/services", location))
+	if err != nil {
+		return err
+	}
+
+	services := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing Dataproc Metastore services: %s", err)
+		}
+
+		if items, ok := res["services"].([]interface{}); ok {
+			services = append(services, flattenDataprocMetastoreServices(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("services", tpgresource.ApplyDatasourceFilters(filters, services)); err != nil {
+		return fmt.Errorf("Error setting Dataproc Metastore services: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/services", project, location))
+
+	return nil
+}
+
+func flattenDataprocMetastoreServices(items []interface{}) []map[string]interface{} {
+	services := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		service, ok := item.(map[string]interface{})
+		if !ok || len(service) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		services = append(services, map[string]interface{}{
+			"name":            service["name"],
+			"state":           service["state"],
+			"tier":            service["tier"],
+			"release_channel": service["releaseChannel"],
+			"labels":          service["labels"],
+		})
+	}
+	return services
+}
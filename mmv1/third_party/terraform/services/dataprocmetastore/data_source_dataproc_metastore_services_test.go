@@ -0,0 +1,79 @@
+package dataprocmetastore_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceDataprocMetastoreServices_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckDataprocMetastoreServiceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDataprocMetastoreServices_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_dataproc_metastore_services.filtered", "services.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_dataproc_metastore_services.filtered", "services.0.name", "google_dataproc_metastore_service.metastore", "name"),
+					resource.TestCheckResourceAttr("data.google_dataproc_metastore_services.filtered", "services.0.state", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.google_dataproc_metastore_services.filtered", "services.0.tier", "DEVELOPER"),
+					resource.TestCheckResourceAttrPair("data.google_dataproc_metastore_services.filtered", "services.0.release_channel", "google_dataproc_metastore_service.metastore", "release_channel"),
+					resource.TestCheckResourceAttr("data.google_dataproc_metastore_services.filtered", "services.0.labels.env", "test"),
+					resource.TestCheckResourceAttr("data.google_dataproc_metastore_services.all_locations", "services.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDataprocMetastoreServices_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_dataproc_metastore_service" "metastore" {
+  service_id = "tf-test-metastore-%{random_suffix}"
+  location   = "us-central1"
+  tier       = "DEVELOPER"
+
+  hive_metastore_config {
+    version = "2.3.6"
+  }
+
+  labels = {
+    env = "test"
+  }
+}
+
+data "google_dataproc_metastore_services" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name   = "name"
+    values = ["/services/tf-test-metastore-%{random_suffix}$"]
+  }
+
+  filters {
+    name   = "tier"
+    values = ["^DEVELOPER$"]
+  }
+
+  depends_on = [google_dataproc_metastore_service.metastore]
+}
+
+data "google_dataproc_metastore_services" "all_locations" {
+  filters {
+    name   = "name"
+    values = ["/services/tf-test-metastore-%{random_suffix}$"]
+  }
+
+  depends_on = [google_dataproc_metastore_service.metastore]
+}
+`, context)
+}
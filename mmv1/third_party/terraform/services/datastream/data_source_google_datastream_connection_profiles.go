@@ -0,0 +1,183 @@
+package datastream
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// datastreamConnectionProfileTypes maps the API field holding a connection
+// profile's configuration to the profile type reported by the data source.
+var datastreamConnectionProfileTypes = map[string]string{
+	"oracleProfile":     "oracle",
+	"gcsProfile":        "gcs",
+	"mysqlProfile":      "mysql",
+	"bigqueryProfile":   "bigquery",
+	"postgresqlProfile": "postgresql",
+	"salesforceProfile": "salesforce",
+	"spannerProfile":    "spanner",
+	"sqlServerProfile":  "sql_server",
+	"mongodbProfile":    "mongodb",
+}
+
+func DataSourceGoogleDatastreamConnectionProfiles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleDatastreamConnectionProfilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the connection profiles are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the connection profiles. If it is not provided, connection profiles across all locations are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("display_name"),
+			"connection_profiles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the connection profile.`,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The display name of the connection profile.`,
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The location of the connection profile.`,
+						},
+						"profile_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The type of the connection profile, for example mysql or bigquery.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleDatastreamConnectionProfilesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for connection profiles: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	location := "-"
+	if v, ok := d.GetOk("location"); ok {
+		location = v.(string)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{DatastreamBasePath}}projects/{{project}}/locations/%s/connectionProfiles", location))
+	if err != nil {
+		return err
+	}
+
+	profiles := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing connection profiles: %s", err)
+		}
+
+		if items, ok := res["connectionProfiles"].([]interface{}); ok {
+			profiles = append(profiles, flattenDatastreamConnectionProfiles(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("connection_profiles", tpgresource.ApplyDatasourceFilters(filters, profiles)); err != nil {
+		return fmt.Errorf("Error setting connection profiles: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/connectionProfiles", project, location))
+
+	return nil
+}
+
+func flattenDatastreamConnectionProfiles(items []interface{}) []map[string]interface{} {
+	profiles := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		profile, ok := item.(map[string]interface{})
+		if !ok || len(profile) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		name, _ := profile["name"].(string)
+		profiles = append(profiles, map[string]interface{}{
+			"name":         name,
+			"display_name": profile["displayName"],
+			"location":     tpgresource.GetRegionFromRegionalSelfLink(name),
+			"profile_type": datastreamConnectionProfileType(profile),
+		})
+	}
+	return profiles
+}
+
+func datastreamConnectionProfileType(profile map[string]interface{}) string {
+	types := make([]string, 0, 1)
+	for field, profileType := range datastreamConnectionProfileTypes {
+		if _, ok := profile[field]; ok {
+			types = append(types, profileType)
+		}
+	}
+	// A connection profile holds a single configuration, but keep the result
+	// stable should the API ever return more than one.
+	sort.Strings(types)
+	return strings.Join(types, ",")
+}
@@ -0,0 +1,83 @@
+package datastream_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceGoogleDatastreamConnectionProfiles_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckDatastreamConnectionProfileDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleDatastreamConnectionProfiles_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_datastream_connection_profiles.filtered", "connection_profiles.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_datastream_connection_profiles.filtered", "connection_profiles.0.name", "google_datastream_connection_profile.first", "name"),
+					resource.TestCheckResourceAttr("data.google_datastream_connection_profiles.filtered", "connection_profiles.0.location", "us-central1"),
+					resource.TestCheckResourceAttr("data.google_datastream_connection_profiles.filtered", "connection_profiles.0.profile_type", "gcs"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_datastream_connection_profiles.all_locations", "connection_profiles.*", map[string]string{
+						"display_name": "tf-test-second-" + context["random_suffix"].(string),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleDatastreamConnectionProfiles_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_datastream_connection_profile" "first" {
+  display_name          = "tf-test-first-%{random_suffix}"
+  location              = "us-central1"
+  connection_profile_id = "tf-test-first-%{random_suffix}"
+
+  gcs_profile {
+    bucket    = "my-bucket"
+    root_path = "/path"
+  }
+}
+
+resource "google_datastream_connection_profile" "second" {
+  display_name          = "tf-test-second-%{random_suffix}"
+  location              = "us-central1"
+  connection_profile_id = "tf-test-second-%{random_suffix}"
+
+  gcs_profile {
+    bucket    = "my-bucket"
+    root_path = "/path"
+  }
+}
+
+data "google_datastream_connection_profiles" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name   = "display_name"
+    values = ["^tf-test-first-%{random_suffix}$"]
+  }
+
+  depends_on = [
+    google_datastream_connection_profile.first,
+    google_datastream_connection_profile.second,
+  ]
+}
+
+data "google_datastream_connection_profiles" "all_locations" {
+  depends_on = [
+    google_datastream_connection_profile.first,
+    google_datastream_connection_profile.second,
+  ]
+}
+`, context)
+}
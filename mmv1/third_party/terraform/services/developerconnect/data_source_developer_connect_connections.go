@@ -0,0 +1,187 @@
+package developerconnect
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDeveloperConnectConnections() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDeveloperConnectConnectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the connections are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the connections. If it is not provided, connections across all locations are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name"),
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the connection.`,
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Whether the connection is disabled.`,
+						},
+						"installation_state": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The state of the installation of the connection with the Git provider.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"stage": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The stage of the installation, for example PENDING_USER_OAUTH or COMPLETE.`,
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `A message with additional information about the stage.`,
+									},
+									"action_uri": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `A link to follow for the next action of the installation, if any.`,
+									},
+								},
+							},
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The labels of the connection, including labels configured outside of Terraform.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDeveloperConnectConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Developer Connect connections: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	location := "-"
+	if v, ok := d.GetOk("location"); ok {
+		location = v.(string)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{DeveloperConnectBasePath}}projects/{{project}}/locations/%s/connections", location))
+	if err != nil {
+		return err
+	}
+
+	connections := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing Developer Connect connections: %s", err)
+		}
+
+		if items, ok := res["connections"].([]interface{}); ok {
+			connections = append(connections, flattenDeveloperConnectConnections(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("connections", tpgresource.ApplyDatasourceFilters(filters, connections)); err != nil {
+		return fmt.Errorf("Error setting Developer Connect connections: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/connections", project, location))
+
+	return nil
+}
+
+func flattenDeveloperConnectConnections(items []interface{}) []map[string]interface{} {
+	connections := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		connection, ok := item.(map[string]interface{})
+		if !ok || len(connection) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		connections = append(connections, map[string]interface{}{
+			"name":               connection["name"],
+			"disabled":           connection["disabled"],
+			"installation_state": flattenDeveloperConnectConnectionsInstallationState(connection),
+			"labels":             connection["labels"],
+		})
+	}
+	return connections
+}
+
+func flattenDeveloperConnectConnectionsInstallationState(v map[string]interface{}) interface{} {
+	obj, ok := v["installationState"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"stage":      obj["stage"],
+			"message":    obj["message"],
+			"action_uri": obj["actionUri"],
+		},
+	}
+}
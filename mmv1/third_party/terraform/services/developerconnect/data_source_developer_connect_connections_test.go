@@ -0,0 +1,76 @@
+package developerconnect_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceDeveloperConnectConnections_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckDeveloperConnectConnectionDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDeveloperConnectConnections_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_developer_connect_connections.filtered", "connections.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_developer_connect_connections.filtered", "connections.0.name", "google_developer_connect_connection.my-connection", "name"),
+					resource.TestCheckResourceAttr("data.google_developer_connect_connections.filtered", "connections.0.disabled", "false"),
+					resource.TestCheckResourceAttrPair("data.google_developer_connect_connections.filtered", "connections.0.installation_state.0.stage", "google_developer_connect_connection.my-connection", "installation_state.0.stage"),
+					resource.TestCheckResourceAttr("data.google_developer_connect_connections.filtered", "connections.0.labels.environment", "dev"),
+					resource.TestCheckResourceAttr("data.google_developer_connect_connections.all_locations", "connections.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDeveloperConnectConnections_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_developer_connect_connection" "my-connection" {
+  location      = "us-central1"
+  connection_id = "tf-test-connection%{random_suffix}"
+
+  github_config {
+    github_app = "DEVELOPER_CONNECT"
+
+    authorizer_credential {
+      oauth_token_secret_version = "projects/devconnect-terraform-creds/secrets/tf-test-do-not-change-github-oauthtoken-e0b9e7/versions/1"
+    }
+  }
+
+  labels = {
+    environment = "dev"
+  }
+}
+
+data "google_developer_connect_connections" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name   = "name"
+    values = ["/connections/tf-test-connection%{random_suffix}$"]
+  }
+
+  depends_on = [google_developer_connect_connection.my-connection]
+}
+
+data "google_developer_connect_connections" "all_locations" {
+  filters {
+    name   = "name"
+    values = ["/connections/tf-test-connection%{random_suffix}$"]
+  }
+
+  depends_on = [google_developer_connect_connection.my-connection]
+}
+`, context)
+}
@@ -0,0 +1,154 @@
+package discoveryengine
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDiscoveryEngineDataStores() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDiscoveryEngineDataStoresRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the data stores are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The geographic location of the data stores, one of global, us or eu.`,
+			},
+			"collection": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "default_collection",
+				Description: `The collection of the data stores.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("display_name"),
+			"data_stores": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the data store.`,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The display name of the data store.`,
+						},
+						"industry_vertical": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The industry vertical of the data store, for example GENERIC or MEDIA.`,
+						},
+						"content_config": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The content config of the data store, for example NO_CONTENT or CONTENT_REQUIRED.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDiscoveryEngineDataStoresRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for data stores: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{DiscoveryEngineBasePath}}projects/{{project}}/locations/{{location}}/collections/{{collection}}/dataStores")
+	if err != nil {
+		return err
+	}
+
+	dataStores := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing data stores: %s", err)
+		}
+
+		if items, ok := res["dataStores"].([]interface{}); ok {
+			dataStores = append(dataStores, flattenDiscoveryEngineDataStores(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("data_stores", tpgresource.ApplyDatasourceFilters(filters, dataStores)); err != nil {
+		return fmt.Errorf("Error setting data stores: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/collections/%s/dataStores", project, d.Get("location").(string), d.Get("collection").(string)))
+
+	return nil
+}
+
+func flattenDiscoveryEngineDataStores(items []interface{}) []map[string]interface{} {
+	dataStores := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		dataStore, ok := item.(map[string]interface{})
+		if !ok || len(dataStore) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		dataStores = append(dataStores, map[string]interface{}{
+			"name":              dataStore["name"],
+			"display_name":      dataStore["displayName"],
+			"industry_vertical": dataStore["industryVertical"],
+			"content_config":    dataStore["contentConfig"],
+		})
+	}
+	return dataStores
+}
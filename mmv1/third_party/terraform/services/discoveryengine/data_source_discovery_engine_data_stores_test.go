@@ -0,0 +1,74 @@
+package discoveryengine_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceDiscoveryEngineDataStores_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckDiscoveryEngineDataStoreDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDiscoveryEngineDataStores_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_discovery_engine_data_stores.filtered", "data_stores.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_discovery_engine_data_stores.filtered", "data_stores.0.name", "google_discovery_engine_data_store.first", "name"),
+					resource.TestCheckResourceAttrPair("data.google_discovery_engine_data_stores.filtered", "data_stores.0.display_name", "google_discovery_engine_data_store.first", "display_name"),
+					resource.TestCheckResourceAttr("data.google_discovery_engine_data_stores.filtered", "data_stores.0.industry_vertical", "GENERIC"),
+					resource.TestCheckResourceAttr("data.google_discovery_engine_data_stores.filtered", "data_stores.0.content_config", "NO_CONTENT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDiscoveryEngineDataStores_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_discovery_engine_data_store" "first" {
+  location                     = "global"
+  data_store_id                = "tf-test-first-%{random_suffix}"
+  display_name                 = "tf-test-first-%{random_suffix}"
+  industry_vertical            = "GENERIC"
+  content_config               = "NO_CONTENT"
+  solution_types               = ["SOLUTION_TYPE_SEARCH"]
+  create_advanced_site_search  = false
+  skip_default_schema_creation = false
+}
+
+resource "google_discovery_engine_data_store" "second" {
+  location                     = "global"
+  data_store_id                = "tf-test-second-%{random_suffix}"
+  display_name                 = "tf-test-second-%{random_suffix}"
+  industry_vertical            = "GENERIC"
+  content_config               = "NO_CONTENT"
+  solution_types               = ["SOLUTION_TYPE_SEARCH"]
+  create_advanced_site_search  = false
+  skip_default_schema_creation = false
+}
+
+data "google_discovery_engine_data_stores" "filtered" {
+  location = "global"
+
+  filters {
+    name   = "display_name"
+    values = ["^tf-test-first-%{random_suffix}$"]
+  }
+
+  depends_on = [
+    google_discovery_engine_data_store.first,
+    google_discovery_engine_data_store.second,
+  ]
+}
+`, context)
+}
@@ -0,0 +1,146 @@
+package gemini
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceGeminiCodeRepositoryIndexes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGeminiCodeRepositoryIndexesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the code repository indexes are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the code repository indexes. If it is not provided, code repository indexes across all Gemini locations of the project are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "state"),
+			"code_repository_indexes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the code repository index.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The state of the code repository index, such as ACTIVE.`,
+						},
+						"kms_key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The Cloud KMS key that encrypts the code repository index, if any.`,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The labels of the code repository index, including labels configured outside of Terraform.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGeminiCodeRepositoryIndexesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Gemini code repository indexes: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	locations := []string{d.Get("location").(string)}
+	id := fmt.Sprintf("projects/%s/locations/%s/codeRepositoryIndexes", project, locations[0])
+	if locations[0] == "" {
+		locations, err = transport_tpg.ListLocationIds(config, billingProject, fmt.Sprintf("%sprojects/%s/locations", config.GeminiBasePath, project), userAgent, "locations", "locationId")
+		if err != nil {
+			return fmt.Errorf("Error listing Gemini locations: %s", err)
+		}
+		id = fmt.Sprintf("projects/%s/locations/-/codeRepositoryIndexes", project)
+	}
+
+	items, err := transport_tpg.ListAcrossLocations(locations, func(location string) ([]interface{}, error) {
+		return listGeminiCodeRepositoryIndexes(config, billingProject, project, location, userAgent)
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing Gemini code repository indexes: %s", err)
+	}
+	indexes := flattenGeminiCodeRepositoryIndexes(items)
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("code_repository_indexes", tpgresource.ApplyDatasourceFilters(filters, indexes)); err != nil {
+		return fmt.Errorf("Error setting Gemini code repository indexes: %s", err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+// listGeminiCodeRepositoryIndexes returns the code repository indexes of a
+// single location.
+func listGeminiCodeRepositoryIndexes(config *transport_tpg.Config, billingProject, project, location, userAgent string) ([]interface{}, error) {
+	url := fmt.Sprintf("%sprojects/%s/locations/%s/codeRepositoryIndexes", config.GeminiBasePath, project, location)
+	return transport_tpg.ListAllPages(func(pageToken string) ([]interface{}, string, error) {
+		res, nextPageToken, err := transport_tpg.SendListRequest(config, billingProject, url, userAgent, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		items, _ := res["codeRepositoryIndexes"].([]interface{})
+		return items, nextPageToken, nil
+	}, transport_tpg.SendRequestRetryOptions)
+}
+
+func flattenGeminiCodeRepositoryIndexes(items []interface{}) []map[string]interface{} {
+	indexes := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		index, ok := item.(map[string]interface{})
+		if !ok || len(index) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		indexes = append(indexes, map[string]interface{}{
+			"name":    index["name"],
+			"state":   index["state"],
+			"kms_key": index["kmsKey"],
+			"labels":  index["labels"],
+		})
+	}
+	return indexes
+}
@@ -0,0 +1,84 @@
+package gemini_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceGeminiCodeRepositoryIndexes_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGeminiCodeRepositoryIndexes_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_gemini_code_repository_indexes.filtered", "code_repository_indexes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_gemini_code_repository_indexes.filtered", "code_repository_indexes.0.name", "google_gemini_code_repository_index.index", "name"),
+					resource.TestCheckResourceAttr("data.google_gemini_code_repository_indexes.filtered", "code_repository_indexes.0.state", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.google_gemini_code_repository_indexes.filtered", "code_repository_indexes.0.kms_key", ""),
+					resource.TestCheckResourceAttr("data.google_gemini_code_repository_indexes.filtered", "code_repository_indexes.0.labels.environment", "dev"),
+					resource.TestCheckResourceAttr("data.google_gemini_code_repository_indexes.all_locations", "code_repository_indexes.#", "1"),
+					resource.TestCheckResourceAttr("data.google_gemini_code_repository_indexes.creating", "code_repository_indexes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGeminiCodeRepositoryIndexes_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_gemini_code_repository_index" "index" {
+  location                 = "us-central1"
+  code_repository_index_id = "tf-test-cri-%{random_suffix}"
+
+  labels = {
+    environment = "dev"
+  }
+}
+
+data "google_gemini_code_repository_indexes" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name   = "name"
+    values = ["/codeRepositoryIndexes/tf-test-cri-%{random_suffix}$"]
+  }
+
+  depends_on = [google_gemini_code_repository_index.index]
+}
+
+data "google_gemini_code_repository_indexes" "all_locations" {
+  filters {
+    name   = "name"
+    values = ["/codeRepositoryIndexes/tf-test-cri-%{random_suffix}$"]
+  }
+
+  depends_on = [google_gemini_code_repository_index.index]
+}
+
+data "google_gemini_code_repository_indexes" "creating" {
+  location = "us-central1"
+
+  filters {
+    name   = "name"
+    values = ["/codeRepositoryIndexes/tf-test-cri-%{random_suffix}$"]
+  }
+
+  filters {
+    name           = "state"
+    literal_values = ["CREATING"]
+  }
+
+  depends_on = [google_gemini_code_repository_index.index]
+}
+`, context)
+}
@@ -0,0 +1,140 @@
+package gemini
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceGeminiLoggingSettings() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGeminiLoggingSettingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the logging settings are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the logging settings. If it is not provided, logging settings across all Gemini locations of the project are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name"),
+			"logging_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the logging setting.`,
+						},
+						"log_prompts_and_responses": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Whether the prompts sent to Gemini and its responses are logged.`,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The labels of the logging setting, including labels configured outside of Terraform.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGeminiLoggingSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Gemini logging settings: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	locations := []string{d.Get("location").(string)}
+	id := fmt.Sprintf("projects/%s/locations/%s/loggingSettings", project, locations[0])
+	if locations[0] == "" {
+		locations, err = transport_tpg.ListLocationIds(config, billingProject, fmt.Sprintf("%sprojects/%s/locations", config.GeminiBasePath, project), userAgent, "locations", "locationId")
+		if err != nil {
+			return fmt.Errorf("Error listing Gemini locations: %s", err)
+		}
+		id = fmt.Sprintf("projects/%s/locations/-/loggingSettings", project)
+	}
+
+	items, err := transport_tpg.ListAcrossLocations(locations, func(location string) ([]interface{}, error) {
+		return listGeminiLoggingSettings(config, billingProject, project, location, userAgent)
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing Gemini logging settings: %s", err)
+	}
+	settings := flattenGeminiLoggingSettings(items)
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("logging_settings", tpgresource.ApplyDatasourceFilters(filters, settings)); err != nil {
+		return fmt.Errorf("Error setting Gemini logging settings: %s", err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+// listGeminiLoggingSettings returns the logging settings of a single
+// location.
+func listGeminiLoggingSettings(config *transport_tpg.Config, billingProject, project, location, userAgent string) ([]interface{}, error) {
+	url := fmt.Sprintf("%sprojects/%s/locations/%s/loggingSettings", config.GeminiBasePath, project, location)
+	return transport_tpg.ListAllPages(func(pageToken string) ([]interface{}, string, error) {
+		res, nextPageToken, err := transport_tpg.SendListRequest(config, billingProject, url, userAgent, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		items, _ := res["loggingSettings"].([]interface{})
+		return items, nextPageToken, nil
+	}, transport_tpg.SendRequestRetryOptions)
+}
+
+func flattenGeminiLoggingSettings(items []interface{}) []map[string]interface{} {
+	settings := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		setting, ok := item.(map[string]interface{})
+		if !ok || len(setting) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		settings = append(settings, map[string]interface{}{
+			"name":                      setting["name"],
+			"log_prompts_and_responses": setting["logPromptsAndResponses"],
+			"labels":                    setting["labels"],
+		})
+	}
+	return settings
+}
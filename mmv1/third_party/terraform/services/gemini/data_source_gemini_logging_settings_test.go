@@ -0,0 +1,67 @@
+package gemini_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceGeminiLoggingSettings_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGeminiLoggingSettings_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_gemini_logging_settings.filtered", "logging_settings.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_gemini_logging_settings.filtered", "logging_settings.0.name", "google_gemini_logging_setting.setting", "name"),
+					resource.TestCheckResourceAttr("data.google_gemini_logging_settings.filtered", "logging_settings.0.log_prompts_and_responses", "true"),
+					resource.TestCheckResourceAttr("data.google_gemini_logging_settings.filtered", "logging_settings.0.labels.environment", "dev"),
+					resource.TestCheckResourceAttr("data.google_gemini_logging_settings.all_locations", "logging_settings.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGeminiLoggingSettings_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_gemini_logging_setting" "setting" {
+  location                  = "global"
+  logging_setting_id        = "tf-test-ls-%{random_suffix}"
+  log_prompts_and_responses = true
+
+  labels = {
+    environment = "dev"
+  }
+}
+
+data "google_gemini_logging_settings" "filtered" {
+  location = "global"
+
+  filters {
+    name   = "name"
+    values = ["/loggingSettings/tf-test-ls-%{random_suffix}$"]
+  }
+
+  depends_on = [google_gemini_logging_setting.setting]
+}
+
+data "google_gemini_logging_settings" "all_locations" {
+  filters {
+    name   = "name"
+    values = ["/loggingSettings/tf-test-ls-%{random_suffix}$"]
+  }
+
+  depends_on = [google_gemini_logging_setting.setting]
+}
+`, context)
+}
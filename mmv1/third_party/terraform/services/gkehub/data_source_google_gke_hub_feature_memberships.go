@@ -0,0 +1,292 @@
+package gkehub
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func DataSourceGoogleGkeHubFeatureMemberships() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleGkeHubFeatureMembershipsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the features are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "global",
+				Description: `The location of the features. Defaults to "global".`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("feature", "membership"),
+			"feature_memberships": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"feature": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the feature, for example configmanagement.`,
+						},
+						"membership": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the membership the feature is configured for.`,
+						},
+						"configmanagement": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The Config Management configuration of the membership. Only set for the configmanagement feature.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"version": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The version of Config Management installed.`,
+									},
+									"management": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Whether Config Management is managed automatically or manually.`,
+									},
+									"config_sync": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: `The Config Sync configuration of the membership.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:        schema.TypeBool,
+													Computed:    true,
+													Description: `Whether Config Sync is enabled.`,
+												},
+												"source_format": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: `The format of the synced repository, either hierarchy or unstructured.`,
+												},
+												"prevent_drift": {
+													Type:        schema.TypeBool,
+													Computed:    true,
+													Description: `Whether the Config Sync admission webhook prevents drift.`,
+												},
+												"git": {
+													Type:        schema.TypeList,
+													Computed:    true,
+													Description: `The Git repository synced by Config Sync.`,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"sync_repo": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Description: `The URL of the Git repository.`,
+															},
+															"sync_branch": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Description: `The branch of the repository to sync from.`,
+															},
+															"policy_dir": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Description: `The path within the repository to sync from.`,
+															},
+															"secret_type": {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Description: `The type of secret used to access the repository.`,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The state code of the feature on the membership, for example OK.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleGkeHubFeatureMembershipsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for feature memberships: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{GKEHubBasePath}}projects/{{project}}/locations/{{location}}/features")
+	if err != nil {
+		return err
+	}
+
+	featureMemberships := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing features: %s", err)
+		}
+
+		if items, ok := res["resources"].([]interface{}); ok {
+			featureMemberships = append(featureMemberships, flattenGkeHubFeatureMemberships(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("feature_memberships", tpgresource.ApplyDatasourceFilters(filters, featureMemberships)); err != nil {
+		return fmt.Errorf("Error setting feature memberships: %s", err)
+	}
+
+	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/locations/{{location}}/features/-/memberships")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return nil
+}
+
+// flattenGkeHubFeatureMemberships returns an entry for every membership of
+// every feature. Features have no list of memberships of their own: each
+// membership is a key of the membershipSpecs and membershipStates maps of the
+// feature, so memberships with only a spec or only a state are both included.
+func flattenGkeHubFeatureMemberships(items []interface{}) []map[string]interface{} {
+	featureMemberships := make([]map[string]interface{}, 0)
+	for _, item := range items {
+		feature, ok := item.(map[string]interface{})
+		if !ok || len(feature) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		name, _ := feature["name"].(string)
+		specs, _ := feature["membershipSpecs"].(map[string]interface{})
+		states, _ := feature["membershipStates"].(map[string]interface{})
+
+		keys := make([]string, 0, len(specs))
+		for key := range specs {
+			keys = append(keys, key)
+		}
+		for key := range states {
+			if _, ok := specs[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			spec, _ := specs[key].(map[string]interface{})
+			state := ""
+			if membershipState, ok := states[key].(map[string]interface{}); ok {
+				if s, ok := membershipState["state"].(map[string]interface{}); ok {
+					state, _ = s["code"].(string)
+				}
+			}
+
+			featureMemberships = append(featureMemberships, map[string]interface{}{
+				"feature":          tpgresource.GetResourceNameFromSelfLink(name),
+				"membership":       tpgresource.GetResourceNameFromSelfLink(key),
+				"configmanagement": flattenGkeHubFeatureMembershipsConfigmanagement(spec),
+				"state":            state,
+			})
+		}
+	}
+	return featureMemberships
+}
+
+func flattenGkeHubFeatureMembershipsConfigmanagement(spec map[string]interface{}) interface{} {
+	obj, ok := spec["configmanagement"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"version":     obj["version"],
+			"management":  obj["management"],
+			"config_sync": flattenGkeHubFeatureMembershipsConfigmanagementConfigSync(obj),
+		},
+	}
+}
+
+func flattenGkeHubFeatureMembershipsConfigmanagementConfigSync(v map[string]interface{}) interface{} {
+	obj, ok := v["configSync"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":       obj["enabled"],
+			"source_format": obj["sourceFormat"],
+			"prevent_drift": obj["preventDrift"],
+			"git":           flattenGkeHubFeatureMembershipsConfigmanagementConfigSyncGit(obj),
+		},
+	}
+}
+
+func flattenGkeHubFeatureMembershipsConfigmanagementConfigSyncGit(v map[string]interface{}) interface{} {
+	obj, ok := v["git"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"sync_repo":   obj["syncRepo"],
+			"sync_branch": obj["syncBranch"],
+			"policy_dir":  obj["policyDir"],
+			"secret_type": obj["secretType"],
+		},
+	}
+}
@@ -0,0 +1,126 @@
+package gkehub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccDataSourceGoogleGkeHubFeatureMemberships_basic(t *testing.T) {
+	// Multiple fine-grained resources cause VCR to fail
+	acctest.SkipIfVcr(t)
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix":   acctest.RandString(t, 10),
+		"org_id":          envvar.GetTestOrgFromEnv(t),
+		"billing_account": envvar.GetTestBillingAccountFromEnv(t),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {},
+		},
+		CheckDestroy: testAccCheckGKEHubFeatureDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleGkeHubFeatureMemberships_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_gke_hub_feature_memberships.all", "feature_memberships.#", "2"),
+					resource.TestCheckResourceAttr("data.google_gke_hub_feature_memberships.filtered", "feature_memberships.#", "1"),
+					resource.TestCheckResourceAttr("data.google_gke_hub_feature_memberships.filtered", "feature_memberships.0.feature", "configmanagement"),
+					resource.TestCheckResourceAttr("data.google_gke_hub_feature_memberships.filtered", "feature_memberships.0.membership", "tf-test1"+context["random_suffix"].(string)),
+					resource.TestCheckResourceAttr("data.google_gke_hub_feature_memberships.filtered", "feature_memberships.0.configmanagement.0.version", "1.21.0"),
+					resource.TestCheckResourceAttr("data.google_gke_hub_feature_memberships.filtered", "feature_memberships.0.configmanagement.0.config_sync.0.enabled", "true"),
+					resource.TestCheckResourceAttr("data.google_gke_hub_feature_memberships.filtered", "feature_memberships.0.configmanagement.0.config_sync.0.git.0.sync_repo", "https://github.com/GoogleCloudPlatform/magic-modules"),
+					resource.TestCheckResourceAttr("data.google_gke_hub_feature_memberships.excluded", "feature_memberships.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleGkeHubFeatureMemberships_basic(context map[string]interface{}) string {
+	return gkeHubFeatureProjectSetup(context) + gkeHubClusterMembershipSetup(context) + acctest.Nprintf(`
+resource "google_gke_hub_feature" "feature" {
+  project  = google_project.project.project_id
+  name     = "configmanagement"
+  location = "global"
+
+  depends_on = [time_sleep.wait_120s]
+}
+
+resource "google_gke_hub_feature_membership" "feature_member_1" {
+  project    = google_project.project.project_id
+  location   = "global"
+  feature    = google_gke_hub_feature.feature.name
+  membership = google_gke_hub_membership.membership.membership_id
+  configmanagement {
+    version = "1.21.0"
+    config_sync {
+      enabled       = true
+      source_format = "hierarchy"
+      git {
+        sync_repo   = "https://github.com/GoogleCloudPlatform/magic-modules"
+        secret_type = "none"
+      }
+    }
+  }
+}
+
+resource "google_gke_hub_feature_membership" "feature_member_2" {
+  project    = google_project.project.project_id
+  location   = "global"
+  feature    = google_gke_hub_feature.feature.name
+  membership = google_gke_hub_membership.membership_second.membership_id
+  configmanagement {
+    version = "1.21.0"
+    config_sync {
+      enabled       = true
+      source_format = "unstructured"
+      git {
+        sync_repo   = "https://github.com/terraform-providers/terraform-provider-google"
+        secret_type = "none"
+      }
+    }
+  }
+}
+
+data "google_gke_hub_feature_memberships" "all" {
+  project = google_project.project.project_id
+
+  filters {
+    name   = "feature"
+    values = ["^configmanagement$"]
+  }
+
+  depends_on = [google_gke_hub_feature_membership.feature_member_1, google_gke_hub_feature_membership.feature_member_2]
+}
+
+data "google_gke_hub_feature_memberships" "filtered" {
+  project = google_project.project.project_id
+
+  filters {
+    name   = "membership"
+    values = ["^tf-test1%{random_suffix}$"]
+  }
+
+  depends_on = [google_gke_hub_feature_membership.feature_member_1, google_gke_hub_feature_membership.feature_member_2]
+}
+
+data "google_gke_hub_feature_memberships" "excluded" {
+  project = google_project.project.project_id
+
+  filters {
+    name           = "membership"
+    exclude_values = ["%{random_suffix}$"]
+  }
+
+  depends_on = [google_gke_hub_feature_membership.feature_member_1, google_gke_hub_feature_membership.feature_member_2]
+}
+`, context)
+}
@@ -0,0 +1,157 @@
+package gkehub2
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func DataSourceGoogleGkeHubFleets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleGkeHubFleetsRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the fleets are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "global",
+				Description: `The location of the fleets. Defaults to "global".`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("display_name", "state"),
+			"fleets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the fleet.`,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `A user-assigned display name of the fleet.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The state code of the fleet.`,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `Labels for the fleet.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleGkeHubFleetsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for fleets: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{GKEHub2BasePath}}projects/{{project}}/locations/{{location}}/fleets")
+	if err != nil {
+		return err
+	}
+
+	fleets := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing fleets: %s", err)
+		}
+
+		if items, ok := res["fleets"].([]interface{}); ok {
+			fleets = append(fleets, flattenGkeHubFleets(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("fleets", tpgresource.ApplyDatasourceFilters(filters, fleets)); err != nil {
+		return fmt.Errorf("Error setting fleets: %s", err)
+	}
+
+	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/locations/{{location}}/fleets")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return nil
+}
+
+func flattenGkeHubFleets(items []interface{}) []map[string]interface{} {
+	fleets := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		fleet, ok := item.(map[string]interface{})
+		if !ok || len(fleet) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		state := ""
+		if s, ok := fleet["state"].(map[string]interface{}); ok {
+			state, _ = s["code"].(string)
+		}
+
+		fleets = append(fleets, map[string]interface{}{
+			"name":         fleet["name"],
+			"display_name": fleet["displayName"],
+			"state":        state,
+			"labels":       fleet["labels"],
+		})
+	}
+	return fleets
+}
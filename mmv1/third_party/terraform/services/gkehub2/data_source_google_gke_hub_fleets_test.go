@@ -0,0 +1,83 @@
+package gkehub2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccDataSourceGoogleGkeHubFleets_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix":   acctest.RandString(t, 10),
+		"org_id":          envvar.GetTestOrgFromEnv(t),
+		"billing_account": envvar.GetTestBillingAccountFromEnv(t),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"time": {},
+		},
+		CheckDestroy: testAccCheckGKEHub2FleetDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleGkeHubFleets_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_gke_hub_fleets.all", "fleets.#", "1"),
+					resource.TestCheckResourceAttr("data.google_gke_hub_fleets.all", "fleets.0.display_name", "tf-test-"+context["random_suffix"].(string)),
+					resource.TestCheckResourceAttrSet("data.google_gke_hub_fleets.all", "fleets.0.name"),
+					resource.TestCheckResourceAttr("data.google_gke_hub_fleets.filtered", "fleets.#", "1"),
+					resource.TestCheckResourceAttr("data.google_gke_hub_fleets.filtered", "fleets.0.state", "READY"),
+					resource.TestCheckResourceAttr("data.google_gke_hub_fleets.excluded", "fleets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleGkeHubFleets_basic(context map[string]interface{}) string {
+	return gkeHubFleetProjectSetupForGA(context) + acctest.Nprintf(`
+resource "google_gke_hub_fleet" "default" {
+  project      = google_project.project.project_id
+  display_name = "tf-test-%{random_suffix}"
+  depends_on   = [time_sleep.wait_for_gkehub_enablement]
+}
+
+data "google_gke_hub_fleets" "all" {
+  project    = google_project.project.project_id
+  depends_on = [google_gke_hub_fleet.default]
+}
+
+data "google_gke_hub_fleets" "filtered" {
+  project = google_project.project.project_id
+
+  filters {
+    name   = "display_name"
+    values = ["^tf-test-"]
+  }
+
+  filters {
+    name   = "state"
+    values = ["^READY$"]
+  }
+
+  depends_on = [google_gke_hub_fleet.default]
+}
+
+data "google_gke_hub_fleets" "excluded" {
+  project = google_project.project.project_id
+
+  filters {
+    name           = "display_name"
+    exclude_values = ["%{random_suffix}$"]
+  }
+
+  depends_on = [google_gke_hub_fleet.default]
+}
+`, context)
+}
@@ -0,0 +1,165 @@
+package logging
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceGoogleLoggingBuckets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleLoggingBucketsRead,
+
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The parent of the log buckets, in the format projects/{project}, folders/{folder}, organizations/{organization} or billingAccounts/{billing_account}.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the log buckets. If it is not provided, log buckets across all locations are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "lifecycle_state"),
+			"buckets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the log bucket.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The description of the log bucket.`,
+						},
+						"retention_days": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of days log entries are retained in the log bucket.`,
+						},
+						"locked": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Whether the log bucket is locked, in which case its retention can no longer be changed.`,
+						},
+						"lifecycle_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The lifecycle state of the log bucket, for example ACTIVE or DELETE_REQUESTED.`,
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The location of the log bucket.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleLoggingBucketsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	location := "-"
+	if v, ok := d.GetOk("location"); ok {
+		location = v.(string)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{LoggingBasePath}}{{parent}}/locations/%s/buckets", location))
+	if err != nil {
+		return err
+	}
+
+	buckets := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing log buckets: %s", err)
+		}
+
+		if items, ok := res["buckets"].([]interface{}); ok {
+			buckets = append(buckets, flattenGoogleLoggingBuckets(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("buckets", tpgresource.ApplyDatasourceFilters(filters, buckets)); err != nil {
+		return fmt.Errorf("Error setting log buckets: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/locations/%s/buckets", d.Get("parent").(string), location))
+
+	return nil
+}
+
+func flattenGoogleLoggingBuckets(items []interface{}) []map[string]interface{} {
+	buckets := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		bucket, ok := item.(map[string]interface{})
+		if !ok || len(bucket) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		name, _ := bucket["name"].(string)
+		buckets = append(buckets, map[string]interface{}{
+			"name":            name,
+			"description":     bucket["description"],
+			"retention_days":  flattenGoogleLoggingBucketsRetentionDays(bucket),
+			"locked":          bucket["locked"],
+			"lifecycle_state": bucket["lifecycleState"],
+			"location":        tpgresource.GetRegionFromRegionalSelfLink(name),
+		})
+	}
+	return buckets
+}
+
+func flattenGoogleLoggingBucketsRetentionDays(v map[string]interface{}) interface{} {
+	if n, ok := v["retentionDays"].(float64); ok {
+		return int(n)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package logging_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccDataSourceGoogleLoggingBuckets_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       envvar.GetTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleLoggingBuckets_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_logging_buckets.filtered", "buckets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_logging_buckets.filtered", "buckets.0.name", "google_logging_project_bucket_config.first", "id"),
+					resource.TestCheckResourceAttr("data.google_logging_buckets.filtered", "buckets.0.description", "First bucket"),
+					resource.TestCheckResourceAttr("data.google_logging_buckets.filtered", "buckets.0.retention_days", "30"),
+					resource.TestCheckResourceAttr("data.google_logging_buckets.filtered", "buckets.0.locked", "false"),
+					resource.TestCheckResourceAttr("data.google_logging_buckets.filtered", "buckets.0.lifecycle_state", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.google_logging_buckets.filtered", "buckets.0.location", "global"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_logging_buckets.all_locations", "buckets.*", map[string]string{
+						"description":    "Second bucket",
+						"retention_days": "60",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleLoggingBuckets_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_logging_project_bucket_config" "first" {
+  project        = "%{project}"
+  location       = "global"
+  retention_days = 30
+  bucket_id      = "tf-test-first-%{random_suffix}"
+  description    = "First bucket"
+}
+
+resource "google_logging_project_bucket_config" "second" {
+  project        = "%{project}"
+  location       = "global"
+  retention_days = 60
+  bucket_id      = "tf-test-second-%{random_suffix}"
+  description    = "Second bucket"
+}
+
+data "google_logging_buckets" "filtered" {
+  parent   = "projects/%{project}"
+  location = "global"
+
+  filters {
+    name   = "name"
+    values = ["/buckets/tf-test-first-%{random_suffix}$"]
+  }
+
+  filters {
+    name   = "lifecycle_state"
+    values = ["^ACTIVE$"]
+  }
+
+  depends_on = [
+    google_logging_project_bucket_config.first,
+    google_logging_project_bucket_config.second,
+  ]
+}
+
+data "google_logging_buckets" "all_locations" {
+  parent = "projects/%{project}"
+
+  depends_on = [
+    google_logging_project_bucket_config.first,
+    google_logging_project_bucket_config.second,
+  ]
+}
+`, context)
+}
@@ -0,0 +1,129 @@
+package logging
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceGoogleLoggingLogViews() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleLoggingLogViewsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The full resource name of the log bucket containing the views, for example projects/my-project/locations/global/buckets/my-bucket.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "description"),
+			"views": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the log view.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The description of the log view.`,
+						},
+						"filter": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The filter selecting the log entries visible through the log view.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleLoggingLogViewsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{LoggingBasePath}}{{bucket}}/views")
+	if err != nil {
+		return err
+	}
+
+	views := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing log views: %s", err)
+		}
+
+		if items, ok := res["views"].([]interface{}); ok {
+			views = append(views, flattenGoogleLoggingLogViews(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("views", tpgresource.ApplyDatasourceFilters(filters, views)); err != nil {
+		return fmt.Errorf("Error setting log views: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/views", d.Get("bucket").(string)))
+
+	return nil
+}
+
+func flattenGoogleLoggingLogViews(items []interface{}) []map[string]interface{} {
+	views := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		view, ok := item.(map[string]interface{})
+		if !ok || len(view) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		views = append(views, map[string]interface{}{
+			"name":        view["name"],
+			"description": view["description"],
+			"filter":      view["filter"],
+		})
+	}
+	return views
+}
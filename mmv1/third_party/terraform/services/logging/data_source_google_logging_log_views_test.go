@@ -0,0 +1,91 @@
+package logging_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccDataSourceGoogleLoggingLogViews_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       envvar.GetTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckLoggingLogViewDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleLoggingLogViews_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_logging_log_views.filtered", "views.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_logging_log_views.filtered", "views.0.name", "google_logging_log_view.first", "id"),
+					resource.TestCheckResourceAttr("data.google_logging_log_views.filtered", "views.0.description", "First view"),
+					resource.TestCheckResourceAttrPair("data.google_logging_log_views.filtered", "views.0.filter", "google_logging_log_view.first", "filter"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_logging_log_views.by_description", "views.*", map[string]string{
+						"description": "Second view",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleLoggingLogViews_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_logging_project_bucket_config" "bucket" {
+  project        = "%{project}"
+  location       = "global"
+  retention_days = 30
+  bucket_id      = "tf-test-bucket-%{random_suffix}"
+}
+
+resource "google_logging_log_view" "first" {
+  name        = "tf-test-first-%{random_suffix}"
+  bucket      = google_logging_project_bucket_config.bucket.id
+  description = "First view"
+  filter      = "resource.type = \"gce_instance\""
+}
+
+resource "google_logging_log_view" "second" {
+  name        = "tf-test-second-%{random_suffix}"
+  bucket      = google_logging_project_bucket_config.bucket.id
+  description = "Second view"
+  filter      = "resource.type = \"gcs_bucket\""
+}
+
+data "google_logging_log_views" "filtered" {
+  bucket = google_logging_project_bucket_config.bucket.id
+
+  filters {
+    name   = "name"
+    values = ["/views/tf-test-first-%{random_suffix}$"]
+  }
+
+  depends_on = [
+    google_logging_log_view.first,
+    google_logging_log_view.second,
+  ]
+}
+
+data "google_logging_log_views" "by_description" {
+  bucket = google_logging_project_bucket_config.bucket.id
+
+  filters {
+    name   = "description"
+    values = ["^Second"]
+  }
+
+  depends_on = [
+    google_logging_log_view.first,
+    google_logging_log_view.second,
+  ]
+}
+`, context)
+}
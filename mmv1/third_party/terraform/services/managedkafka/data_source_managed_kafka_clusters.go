@@ -0,0 +1,201 @@
+package managedkafka
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceManagedKafkaClusters() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagedKafkaClustersRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the clusters are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the clusters. If it is not provided, clusters across all locations are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "state"),
+			"clusters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the cluster.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The state of the cluster, for example CREATING or ACTIVE.`,
+						},
+						"capacity_config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The capacity configuration of the cluster.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"vcpu_count": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: `The number of vCPUs provisioned for the cluster.`,
+									},
+									"memory_bytes": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: `The memory provisioned for the cluster, in bytes.`,
+									},
+								},
+							},
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The labels of the cluster, including labels configured outside of Terraform.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceManagedKafkaClustersRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Managed Kafka clusters: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	location := "-"
+	if v, ok := d.GetOk("location"); ok {
+		location = v.(string)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{ManagedKafkaBasePath}}projects/{{project}}/locations/%s/clusters", location))
+	if err != nil {
+		return err
+	}
+
+	clusters := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing Managed Kafka clusters: %s", err)
+		}
+
+		if items, ok := res["clusters"].([]interface{}); ok {
+			clusters = append(clusters, flattenManagedKafkaClusters(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("clusters", tpgresource.ApplyDatasourceFilters(filters, clusters)); err != nil {
+		return fmt.Errorf("Error setting Managed Kafka clusters: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/clusters", project, location))
+
+	return nil
+}
+
+func flattenManagedKafkaClusters(items []interface{}) []map[string]interface{} {
+	clusters := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		cluster, ok := item.(map[string]interface{})
+		if !ok || len(cluster) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		clusters = append(clusters, map[string]interface{}{
+			"name":            cluster["name"],
+			"state":           cluster["state"],
+			"capacity_config": flattenManagedKafkaClustersCapacityConfig(cluster),
+			"labels":          cluster["labels"],
+		})
+	}
+	return clusters
+}
+
+func flattenManagedKafkaClustersCapacityConfig(v map[string]interface{}) interface{} {
+	obj, ok := v["capacityConfig"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"vcpu_count":   flattenManagedKafkaClustersCapacityConfigVcpuCount(obj),
+			"memory_bytes": flattenManagedKafkaClustersCapacityConfigMemoryBytes(obj),
+		},
+	}
+}
+
+func flattenManagedKafkaClustersCapacityConfigVcpuCount(v map[string]interface{}) interface{} {
+	// The API returns int64 fields as strings.
+	if n, ok := v["vcpuCount"].(string); ok {
+		if i, err := tpgresource.StringToFixed64(n); err == nil {
+			return i
+		}
+	}
+	return nil
+}
+
+func flattenManagedKafkaClustersCapacityConfigMemoryBytes(v map[string]interface{}) interface{} {
+	// The API returns int64 fields as strings.
+	if n, ok := v["memoryBytes"].(string); ok {
+		if i, err := tpgresource.StringToFixed64(n); err == nil {
+			return i
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package managedkafka_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceManagedKafkaClusters_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckManagedKafkaClusterDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceManagedKafkaClusters_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_managed_kafka_clusters.filtered", "clusters.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_managed_kafka_clusters.filtered", "clusters.0.name", "google_managed_kafka_cluster.cluster", "name"),
+					resource.TestCheckResourceAttr("data.google_managed_kafka_clusters.filtered", "clusters.0.state", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.google_managed_kafka_clusters.filtered", "clusters.0.capacity_config.0.vcpu_count", "3"),
+					resource.TestCheckResourceAttr("data.google_managed_kafka_clusters.filtered", "clusters.0.capacity_config.0.memory_bytes", "3221225472"),
+					resource.TestCheckResourceAttr("data.google_managed_kafka_clusters.filtered", "clusters.0.labels.key", "value"),
+					resource.TestCheckResourceAttr("data.google_managed_kafka_clusters.all_locations", "clusters.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceManagedKafkaClusters_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_managed_kafka_cluster" "cluster" {
+  cluster_id = "tf-test-cluster%{random_suffix}"
+  location   = "us-central1"
+  capacity_config {
+    vcpu_count   = 3
+    memory_bytes = 3221225472
+  }
+  gcp_config {
+    access_config {
+      network_configs {
+        subnet = "projects/${data.google_project.project.number}/regions/us-central1/subnetworks/default"
+      }
+    }
+  }
+  labels = {
+    key = "value"
+  }
+}
+
+data "google_project" "project" {
+}
+
+data "google_managed_kafka_clusters" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name   = "name"
+    values = ["/clusters/tf-test-cluster%{random_suffix}$"]
+  }
+
+  filters {
+    name   = "state"
+    values = ["^ACTIVE$"]
+  }
+
+  depends_on = [google_managed_kafka_cluster.cluster]
+}
+
+data "google_managed_kafka_clusters" "all_locations" {
+  filters {
+    name   = "name"
+    values = ["/clusters/tf-test-cluster%{random_suffix}$"]
+  }
+
+  depends_on = [google_managed_kafka_cluster.cluster]
+}
+`, context)
+}
@@ -0,0 +1,150 @@
+package managedkafka
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceManagedKafkaTopics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceManagedKafkaTopicsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The cluster of the topics, in the format projects/{project}/locations/{location}/clusters/{cluster}.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name"),
+			"topics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the topic.`,
+						},
+						"partition_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of partitions of the topic.`,
+						},
+						"replication_factor": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of replicas of each partition of the topic.`,
+						},
+						"configs": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The configuration of the topic overridden from the cluster defaults, keyed by Kafka topic property name.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceManagedKafkaTopicsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{ManagedKafkaBasePath}}{{cluster}}/topics")
+	if err != nil {
+		return err
+	}
+
+	topics := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing Managed Kafka topics: %s", err)
+		}
+
+		if items, ok := res["topics"].([]interface{}); ok {
+			topics = append(topics, flattenManagedKafkaTopics(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("topics", tpgresource.ApplyDatasourceFilters(filters, topics)); err != nil {
+		return fmt.Errorf("Error setting Managed Kafka topics: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/topics", d.Get("cluster").(string)))
+
+	return nil
+}
+
+func flattenManagedKafkaTopics(items []interface{}) []map[string]interface{} {
+	topics := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		topic, ok := item.(map[string]interface{})
+		if !ok || len(topic) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		topics = append(topics, map[string]interface{}{
+			"name":               topic["name"],
+			"partition_count":    flattenManagedKafkaTopicsPartitionCount(topic),
+			"replication_factor": flattenManagedKafkaTopicsReplicationFactor(topic),
+			"configs":            topic["configs"],
+		})
+	}
+	return topics
+}
+
+func flattenManagedKafkaTopicsPartitionCount(v map[string]interface{}) interface{} {
+	if n, ok := v["partitionCount"].(float64); ok {
+		return int(n)
+	}
+	return nil
+}
+
+func flattenManagedKafkaTopicsReplicationFactor(v map[string]interface{}) interface{} {
+	if n, ok := v["replicationFactor"].(float64); ok {
+		return int(n)
+	}
+	return nil
+}
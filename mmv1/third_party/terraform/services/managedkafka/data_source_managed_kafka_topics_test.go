@@ -0,0 +1,99 @@
+package managedkafka_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceManagedKafkaTopics_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckManagedKafkaTopicDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceManagedKafkaTopics_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_managed_kafka_topics.all", "topics.#", "2"),
+					resource.TestCheckResourceAttr("data.google_managed_kafka_topics.filtered", "topics.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_managed_kafka_topics.filtered", "topics.0.name", "google_managed_kafka_topic.orders", "name"),
+					resource.TestCheckResourceAttr("data.google_managed_kafka_topics.filtered", "topics.0.partition_count", "2"),
+					resource.TestCheckResourceAttr("data.google_managed_kafka_topics.filtered", "topics.0.replication_factor", "3"),
+					resource.TestCheckResourceAttr("data.google_managed_kafka_topics.filtered", "topics.0.configs.cleanup.policy", "compact"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceManagedKafkaTopics_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_managed_kafka_cluster" "cluster" {
+  cluster_id = "tf-test-cluster%{random_suffix}"
+  location   = "us-central1"
+  capacity_config {
+    vcpu_count   = 3
+    memory_bytes = 3221225472
+  }
+  gcp_config {
+    access_config {
+      network_configs {
+        subnet = "projects/${data.google_project.project.number}/regions/us-central1/subnetworks/default"
+      }
+    }
+  }
+}
+
+resource "google_managed_kafka_topic" "orders" {
+  topic_id           = "tf-test-orders%{random_suffix}"
+  cluster            = google_managed_kafka_cluster.cluster.cluster_id
+  location           = "us-central1"
+  partition_count    = 2
+  replication_factor = 3
+  configs = {
+    "cleanup.policy" = "compact"
+  }
+}
+
+resource "google_managed_kafka_topic" "payments" {
+  topic_id           = "tf-test-payments%{random_suffix}"
+  cluster            = google_managed_kafka_cluster.cluster.cluster_id
+  location           = "us-central1"
+  replication_factor = 3
+}
+
+data "google_project" "project" {
+}
+
+data "google_managed_kafka_topics" "all" {
+  cluster = google_managed_kafka_cluster.cluster.name
+
+  depends_on = [
+    google_managed_kafka_topic.orders,
+    google_managed_kafka_topic.payments,
+  ]
+}
+
+data "google_managed_kafka_topics" "filtered" {
+  cluster = google_managed_kafka_cluster.cluster.name
+
+  filters {
+    name   = "name"
+    values = ["/topics/tf-test-orders"]
+  }
+
+  depends_on = [
+    google_managed_kafka_topic.orders,
+    google_managed_kafka_topic.payments,
+  ]
+}
+`, context)
+}
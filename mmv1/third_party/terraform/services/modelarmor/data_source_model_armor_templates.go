@@ -0,0 +1,141 @@
+package modelarmor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceModelArmorTemplates() *schema.Resource {
+	dsSchema := tpgresource.DatasourceSchemaFromResourceSchema(ResourceModelArmorTemplate().Schema)
+
+	return &schema.Resource{
+		Read: dataSourceModelArmorTemplatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the templates are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the templates. If it is not provided, templates across all Model Armor locations of the project are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name"),
+			"templates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the template.`,
+						},
+						"filter_config": dsSchema["filter_config"],
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The labels of the template, including labels configured outside of Terraform.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceModelArmorTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Model Armor templates: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	locations := []string{d.Get("location").(string)}
+	id := fmt.Sprintf("projects/%s/locations/%s/templates", project, locations[0])
+	if locations[0] == "" {
+		// Model Armor is served from regional endpoints, which do not accept the
+		// "-" location wildcard, so every Model Armor location is listed instead.
+		locations, err = transport_tpg.ListLocationIds(config, billingProject, fmt.Sprintf("%sprojects/%s/locations", config.ModelArmorGlobalBasePath, project), userAgent, "locations", "locationId")
+		if err != nil {
+			return fmt.Errorf("Error listing Model Armor locations: %s", err)
+		}
+		id = fmt.Sprintf("projects/%s/locations/-/templates", project)
+	}
+
+	items, err := transport_tpg.ListAcrossLocations(locations, func(location string) ([]interface{}, error) {
+		return listModelArmorTemplates(config, billingProject, project, location, userAgent)
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing Model Armor templates: %s", err)
+	}
+	templates := flattenModelArmorTemplates(items, d, config)
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("templates", tpgresource.ApplyDatasourceFilters(filters, templates)); err != nil {
+		return fmt.Errorf("Error setting Model Armor templates: %s", err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+// listModelArmorTemplates returns the templates of a single location, read
+// from the regional endpoint of that location.
+func listModelArmorTemplates(config *transport_tpg.Config, billingProject, project, location, userAgent string) ([]interface{}, error) {
+	url := strings.ReplaceAll(config.ModelArmorBasePath, "{{location}}", location) + fmt.Sprintf("projects/%s/locations/%s/templates", project, location)
+	return transport_tpg.ListAllPages(func(pageToken string) ([]interface{}, string, error) {
+		res, nextPageToken, err := transport_tpg.SendListRequest(config, billingProject, url, userAgent, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		items, _ := res["templates"].([]interface{})
+		return items, nextPageToken, nil
+	}, transport_tpg.SendRequestRetryOptions)
+}
+
+func flattenModelArmorTemplates(items []interface{}, d *schema.ResourceData, config *transport_tpg.Config) []map[string]interface{} {
+	templates := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		template, ok := item.(map[string]interface{})
+		if !ok || len(template) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		templates = append(templates, map[string]interface{}{
+			"name":          template["name"],
+			"filter_config": flattenModelArmorTemplateFilterConfig(template["filterConfig"], d, config),
+			"labels":        template["labels"],
+		})
+	}
+	return templates
+}
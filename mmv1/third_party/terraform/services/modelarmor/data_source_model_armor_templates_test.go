@@ -0,0 +1,81 @@
+package modelarmor_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceModelArmorTemplates_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckModelArmorTemplateDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceModelArmorTemplates_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_model_armor_templates.filtered", "templates.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_model_armor_templates.filtered", "templates.0.name", "google_model_armor_template.template", "name"),
+					resource.TestCheckResourceAttr("data.google_model_armor_templates.filtered", "templates.0.filter_config.0.rai_settings.0.rai_filters.0.filter_type", "HATE_SPEECH"),
+					resource.TestCheckResourceAttr("data.google_model_armor_templates.filtered", "templates.0.filter_config.0.rai_settings.0.rai_filters.0.confidence_level", "HIGH"),
+					resource.TestCheckResourceAttr("data.google_model_armor_templates.filtered", "templates.0.filter_config.0.malicious_uri_filter_settings.0.filter_enforcement", "ENABLED"),
+					resource.TestCheckResourceAttr("data.google_model_armor_templates.filtered", "templates.0.labels.environment", "dev"),
+					resource.TestCheckResourceAttr("data.google_model_armor_templates.all_locations", "templates.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceModelArmorTemplates_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_model_armor_template" "template" {
+  location    = "us-central1"
+  template_id = "tf-test-template-%{random_suffix}"
+
+  filter_config {
+    rai_settings {
+      rai_filters {
+        filter_type      = "HATE_SPEECH"
+        confidence_level = "HIGH"
+      }
+    }
+    malicious_uri_filter_settings {
+      filter_enforcement = "ENABLED"
+    }
+  }
+
+  labels = {
+    environment = "dev"
+  }
+}
+
+data "google_model_armor_templates" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name   = "name"
+    values = ["/templates/tf-test-template-%{random_suffix}$"]
+  }
+
+  depends_on = [google_model_armor_template.template]
+}
+
+data "google_model_armor_templates" "all_locations" {
+  filters {
+    name   = "name"
+    values = ["/templates/tf-test-template-%{random_suffix}$"]
+  }
+
+  depends_on = [google_model_armor_template.template]
+}
+`, context)
+}
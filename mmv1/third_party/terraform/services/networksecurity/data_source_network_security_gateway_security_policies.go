@@ -0,0 +1,154 @@
+package networksecurity
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceNetworkSecurityGatewaySecurityPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkSecurityGatewaySecurityPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the gateway security policies are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the gateway security policies. If it is not provided, gateway security policies across all locations are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name"),
+			"gateway_security_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the gateway security policy.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The description of the gateway security policy.`,
+						},
+						"tls_inspection_policy": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the TLS inspection policy used by the gateway security policy, if any.`,
+						},
+						"location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The location of the gateway security policy.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkSecurityGatewaySecurityPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for gateway security policies: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	location := "-"
+	if v, ok := d.GetOk("location"); ok {
+		location = v.(string)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{NetworkSecurityBasePath}}projects/{{project}}/locations/%s/gatewaySecurityPolicies", location))
+	if err != nil {
+		return err
+	}
+
+	policies := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing gateway security policies: %s", err)
+		}
+
+		if items, ok := res["gatewaySecurityPolicies"].([]interface{}); ok {
+			policies = append(policies, flattenNetworkSecurityGatewaySecurityPolicies(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("gateway_security_policies", tpgresource.ApplyDatasourceFilters(filters, policies)); err != nil {
+		return fmt.Errorf("Error setting gateway security policies: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/gatewaySecurityPolicies", project, location))
+
+	return nil
+}
+
+func flattenNetworkSecurityGatewaySecurityPolicies(items []interface{}) []map[string]interface{} {
+	policies := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		policy, ok := item.(map[string]interface{})
+		if !ok || len(policy) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		name, _ := policy["name"].(string)
+		policies = append(policies, map[string]interface{}{
+			"name":                  name,
+			"description":           policy["description"],
+			"tls_inspection_policy": policy["tlsInspectionPolicy"],
+			"location":              tpgresource.GetRegionFromRegionalSelfLink(name),
+		})
+	}
+	return policies
+}
@@ -0,0 +1,73 @@
+package networksecurity_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceNetworkSecurityGatewaySecurityPolicies_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckNetworkSecurityGatewaySecurityPolicyDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNetworkSecurityGatewaySecurityPolicies_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_network_security_gateway_security_policies.filtered", "gateway_security_policies.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_network_security_gateway_security_policies.filtered", "gateway_security_policies.0.name", "google_network_security_gateway_security_policy.first", "id"),
+					resource.TestCheckResourceAttr("data.google_network_security_gateway_security_policies.filtered", "gateway_security_policies.0.description", "first policy"),
+					resource.TestCheckResourceAttr("data.google_network_security_gateway_security_policies.filtered", "gateway_security_policies.0.location", "us-central1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_network_security_gateway_security_policies.all_locations", "gateway_security_policies.*", map[string]string{
+						"description": "second policy",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNetworkSecurityGatewaySecurityPolicies_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_network_security_gateway_security_policy" "first" {
+  name        = "tf-test-first-%{random_suffix}"
+  location    = "us-central1"
+  description = "first policy"
+}
+
+resource "google_network_security_gateway_security_policy" "second" {
+  name        = "tf-test-second-%{random_suffix}"
+  location    = "us-central1"
+  description = "second policy"
+}
+
+data "google_network_security_gateway_security_policies" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name   = "name"
+    values = ["/gatewaySecurityPolicies/tf-test-first-%{random_suffix}$"]
+  }
+
+  depends_on = [
+    google_network_security_gateway_security_policy.first,
+    google_network_security_gateway_security_policy.second,
+  ]
+}
+
+data "google_network_security_gateway_security_policies" "all_locations" {
+  depends_on = [
+    google_network_security_gateway_security_policy.first,
+    google_network_security_gateway_security_policy.second,
+  ]
+}
+`, context)
+}
@@ -0,0 +1,135 @@
+package networksecurity
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceNetworkSecuritySecurityProfiles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkSecuritySecurityProfilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The parent of the security profiles, in the format organizations/{organization_id}.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "global",
+				Description: `The location of the security profiles.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "type"),
+			"security_profiles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the security profile.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The type of the security profile, for example THREAT_PREVENTION.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The description of the security profile.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkSecuritySecurityProfilesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{NetworkSecurityBasePath}}{{parent}}/locations/{{location}}/securityProfiles")
+	if err != nil {
+		return err
+	}
+
+	profiles := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing security profiles: %s", err)
+		}
+
+		if items, ok := res["securityProfiles"].([]interface{}); ok {
+			profiles = append(profiles, flattenNetworkSecuritySecurityProfiles(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("security_profiles", tpgresource.ApplyDatasourceFilters(filters, profiles)); err != nil {
+		return fmt.Errorf("Error setting security profiles: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/locations/%s/securityProfiles", d.Get("parent").(string), d.Get("location").(string)))
+
+	return nil
+}
+
+func flattenNetworkSecuritySecurityProfiles(items []interface{}) []map[string]interface{} {
+	profiles := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		profile, ok := item.(map[string]interface{})
+		if !ok || len(profile) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		profiles = append(profiles, map[string]interface{}{
+			"name":        profile["name"],
+			"type":        profile["type"],
+			"description": profile["description"],
+		})
+	}
+	return profiles
+}
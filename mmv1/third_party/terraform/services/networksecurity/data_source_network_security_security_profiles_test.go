@@ -0,0 +1,79 @@
+package networksecurity_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccDataSourceNetworkSecuritySecurityProfiles_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":        envvar.GetTestOrgFromEnv(t),
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckNetworkSecuritySecurityProfileDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNetworkSecuritySecurityProfiles_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_network_security_security_profiles.filtered", "security_profiles.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_network_security_security_profiles.filtered", "security_profiles.0.name", "google_network_security_security_profile.first", "id"),
+					resource.TestCheckResourceAttr("data.google_network_security_security_profiles.filtered", "security_profiles.0.type", "THREAT_PREVENTION"),
+					resource.TestCheckResourceAttr("data.google_network_security_security_profiles.filtered", "security_profiles.0.description", "first profile"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceNetworkSecuritySecurityProfiles_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_network_security_security_profile" "first" {
+  name        = "tf-test-first-%{random_suffix}"
+  parent      = "organizations/%{org_id}"
+  location    = "global"
+  description = "first profile"
+  type        = "THREAT_PREVENTION"
+}
+
+resource "google_network_security_security_profile" "second" {
+  name        = "tf-test-second-%{random_suffix}"
+  parent      = "organizations/%{org_id}"
+  location    = "global"
+  description = "second profile"
+  type        = "THREAT_PREVENTION"
+}
+
+data "google_network_security_security_profiles" "filtered" {
+  parent = "organizations/%{org_id}"
+
+  filters {
+    name   = "name"
+    values = ["/securityProfiles/tf-test-(first|second)-%{random_suffix}$"]
+  }
+
+  filters {
+    name           = "name"
+    exclude_values = ["second"]
+  }
+
+  filters {
+    name   = "type"
+    values = ["^THREAT_PREVENTION$"]
+  }
+
+  depends_on = [
+    google_network_security_security_profile.first,
+    google_network_security_security_profile.second,
+  ]
+}
+`, context)
+}
@@ -17,14 +17,15 @@ func DataSourceOracleDatabaseAutonomousDatabases() *schema.Resource {
 		},
 		"location": {
 			Type:        schema.TypeString,
-			Required:    true,
-			Description: "location",
+			Optional:    true,
+			Description: "The location of the autonomous databases. If it is not provided, autonomous databases across all locations are listed.",
 		},
+		"filters": tpgresource.DatasourceFiltersSchema("display_name", "state"),
 		"autonomous_databases": {
 			Type:     schema.TypeList,
 			Computed: true,
 			Elem: &schema.Resource{
-				Schema: tpgresource.DatasourceSchemaFromResourceSchema(ResourceOracleDatabaseAutonomousDatabase().Schema),
+				Schema: autonomousDatabasesElemSchema(),
 			},
 		},
 	}
@@ -35,6 +36,25 @@ func DataSourceOracleDatabaseAutonomousDatabases() *schema.Resource {
 
 }
 
+// autonomousDatabasesElemSchema returns the schema of each entry in
+// autonomous_databases: the google_oracle_database_autonomous_database
+// attributes, plus the state and db_version of its properties, which filters
+// can target.
+func autonomousDatabasesElemSchema() map[string]*schema.Schema {
+	elemSchema := tpgresource.DatasourceSchemaFromResourceSchema(ResourceOracleDatabaseAutonomousDatabase().Schema)
+	elemSchema["state"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The state of the autonomous database, for example AVAILABLE. The same as properties.0.state.",
+	}
+	elemSchema["db_version"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The Oracle Database version of the autonomous database. The same as properties.0.db_version.",
+	}
+	return elemSchema
+}
+
 func dataSourceOracleDatabaseAutonomousDatabasesRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
@@ -42,11 +62,21 @@ func dataSourceOracleDatabaseAutonomousDatabasesRead(d *schema.ResourceData, met
 		return err
 	}
 
-	url, err := tpgresource.ReplaceVars(d, config, "{{OracleDatabaseBasePath}}projects/{{project}}/locations/{{location}}/autonomousDatabases")
+	location := "-"
+	if v, ok := d.GetOk("location"); ok {
+		location = v.(string)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{OracleDatabaseBasePath}}projects/{{project}}/locations/%s/autonomousDatabases", location))
 	if err != nil {
 		return fmt.Errorf("Error constructing id: %s", err)
 	}
 
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
 	billingProject := ""
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
@@ -57,31 +87,44 @@ func dataSourceOracleDatabaseAutonomousDatabasesRead(d *schema.ResourceData, met
 	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
 		billingProject = bp
 	}
-	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-		Config:    config,
-		Method:    "GET",
-		Project:   billingProject,
-		RawURL:    url,
-		UserAgent: userAgent,
-	})
 
-	if err != nil {
-		return fmt.Errorf("Error reading autonomousDatabases: %s", err)
+	autonomousDatabases := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error reading autonomousDatabases: %s", err)
+		}
+
+		autonomousDatabases = append(autonomousDatabases, flattenOracleDatabaseautonomousDatabases(res["autonomousDatabases"], d, config)...)
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
 	}
 
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("Error setting autonomousDatabases project: %s", err)
 	}
 
-	if err := d.Set("autonomous_databases", flattenOracleDatabaseautonomousDatabases(res["autonomousDatabases"], d, config)); err != nil {
+	if err := d.Set("autonomous_databases", tpgresource.ApplyDatasourceFilters(filters, autonomousDatabases)); err != nil {
 		return fmt.Errorf("Error setting autonomousDatabases: %s", err)
 	}
 
-	id, err := tpgresource.ReplaceVars(d, config, "projects/{{project}}/locations/{{location}}/autonomousDatabases")
-	if err != nil {
-		return fmt.Errorf("Error constructing id: %s", err)
-	}
-	d.SetId(id)
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/autonomousDatabases", project, location))
 
 	return nil
 }
@@ -94,6 +137,7 @@ func flattenOracleDatabaseautonomousDatabases(v interface{}, d *schema.ResourceD
 	transformed := make([]map[string]interface{}, 0)
 	for _, raw := range l {
 		original := raw.(map[string]interface{})
+		properties, _ := original["properties"].(map[string]interface{})
 		transformed = append(transformed, map[string]interface{}{
 			"name":             flattenOracleDatabaseAutonomousDatabaseName(original["name"], d, config),
 			"database":         flattenOracleDatabaseAutonomousDatabaseDatabase(original["database"], d, config),
@@ -106,6 +150,8 @@ func flattenOracleDatabaseautonomousDatabases(v interface{}, d *schema.ResourceD
 			"create_time":      flattenOracleDatabaseAutonomousDatabaseCreateTime(original["createTime"], d, config),
 			"terraform_labels": flattenOracleDatabaseAutonomousDatabaseTerraformLabels(original["labels"], d, config),
 			"effective_labels": flattenOracleDatabaseAutonomousDatabaseEffectiveLabels(original["labels"], d, config),
+			"state":            properties["state"],
+			"db_version":       properties["dbVersion"],
 		})
 	}
 	return transformed
@@ -31,6 +31,26 @@ func TestAccOracleDatabaseAutonomousDatabases_basic(t *testing.T) {
 	})
 }
 
+func TestAccOracleDatabaseAutonomousDatabases_filters(t *testing.T) {
+	t.Parallel()
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOracleDatabaseAutonomousDatabases_filters(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_oracle_database_autonomous_databases.filtered", "autonomous_databases.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_oracle_database_autonomous_databases.filtered", "autonomous_databases.0.name", "data.google_oracle_database_autonomous_database.my-adb", "name"),
+					resource.TestCheckResourceAttrPair("data.google_oracle_database_autonomous_databases.filtered", "autonomous_databases.0.state", "data.google_oracle_database_autonomous_database.my-adb", "properties.0.state"),
+					resource.TestCheckResourceAttrPair("data.google_oracle_database_autonomous_databases.filtered", "autonomous_databases.0.db_version", "data.google_oracle_database_autonomous_database.my-adb", "properties.0.db_version"),
+					resource.TestCheckTypeSetElemAttrPair("data.google_oracle_database_autonomous_databases.all_locations", "autonomous_databases.*.name", "data.google_oracle_database_autonomous_database.my-adb", "name"),
+				),
+			},
+		},
+	})
+}
+
 func testAccOracleDatabaseAutonomousDatabases_basic() string {
 	return fmt.Sprintf(`
 data "google_oracle_database_autonomous_databases" "my-adbs"{
@@ -39,3 +59,37 @@ data "google_oracle_database_autonomous_databases" "my-adbs"{
 }
 `)
 }
+
+func testAccOracleDatabaseAutonomousDatabases_filters() string {
+	return fmt.Sprintf(`
+data "google_oracle_database_autonomous_database" "my-adb"{
+  autonomous_database_id = "do-not-delete-tf-adb"
+  location = "us-east4"
+  project = "oci-terraform-testing-prod"
+}
+
+data "google_oracle_database_autonomous_databases" "filtered"{
+  location = "us-east4"
+  project = "oci-terraform-testing-prod"
+
+  filters {
+    name           = "display_name"
+    literal_values = [data.google_oracle_database_autonomous_database.my-adb.display_name]
+  }
+
+  filters {
+    name           = "state"
+    literal_values = [data.google_oracle_database_autonomous_database.my-adb.properties.0.state]
+  }
+}
+
+data "google_oracle_database_autonomous_databases" "all_locations"{
+  project = "oci-terraform-testing-prod"
+
+  filters {
+    name           = "display_name"
+    literal_values = [data.google_oracle_database_autonomous_database.my-adb.display_name]
+  }
+}
+`)
+}
@@ -0,0 +1,160 @@
+package parallelstore
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceParallelstoreInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceParallelstoreInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the instances are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The zone of the instances. If it is not provided, instances across all locations are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "state"),
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the instance.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The state of the instance, for example CREATING or ACTIVE.`,
+						},
+						"capacity_gib": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The storage capacity of the instance in GiB.`,
+						},
+						"network": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The VPC network the instance is connected to.`,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The labels of the instance, including labels configured outside of Terraform.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceParallelstoreInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Parallelstore instances: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	location := "-"
+	if v, ok := d.GetOk("location"); ok {
+		location = v.(string)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{ParallelstoreBasePath}}projects/{{project}}/locations/%s/instances", location))
+	if err != nil {
+		return err
+	}
+
+	instances := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing Parallelstore instances: %s", err)
+		}
+
+		if items, ok := res["instances"].([]interface{}); ok {
+			instances = append(instances, flattenParallelstoreInstances(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("instances", tpgresource.ApplyDatasourceFilters(filters, instances)); err != nil {
+		return fmt.Errorf("Error setting Parallelstore instances: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/instances", project, location))
+
+	return nil
+}
+
+func flattenParallelstoreInstances(items []interface{}) []map[string]interface{} {
+	instances := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		instance, ok := item.(map[string]interface{})
+		if !ok || len(instance) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		instances = append(instances, map[string]interface{}{
+			"name":         instance["name"],
+			"state":        instance["state"],
+			"capacity_gib": instance["capacityGib"],
+			"network":      instance["network"],
+			"labels":       instance["labels"],
+		})
+	}
+	return instances
+}
@@ -0,0 +1,103 @@
+package parallelstore_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceParallelstoreInstances_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckParallelstoreInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceParallelstoreInstances_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_parallelstore_instances.filtered", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_parallelstore_instances.filtered", "instances.0.name", "google_parallelstore_instance.instance", "name"),
+					resource.TestCheckResourceAttr("data.google_parallelstore_instances.filtered", "instances.0.state", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.google_parallelstore_instances.filtered", "instances.0.capacity_gib", "12000"),
+					resource.TestMatchResourceAttr("data.google_parallelstore_instances.filtered", "instances.0.network", regexp.MustCompile("/networks/tf-test-network")),
+					resource.TestCheckResourceAttr("data.google_parallelstore_instances.filtered", "instances.0.labels.test", "value"),
+					resource.TestCheckTypeSetElemAttrPair("data.google_parallelstore_instances.all_locations", "instances.*.name", "google_parallelstore_instance.instance", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceParallelstoreInstances_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_parallelstore_instance" "instance" {
+  instance_id            = "tf-test-instance%{random_suffix}"
+  location               = "us-central1-a"
+  description            = "test instance"
+  capacity_gib           = 12000
+  network                = google_compute_network.network.name
+  file_stripe_level      = "FILE_STRIPE_LEVEL_MIN"
+  directory_stripe_level = "DIRECTORY_STRIPE_LEVEL_MIN"
+  deployment_type        = "SCRATCH"
+
+  labels = {
+    test = "value"
+  }
+
+  depends_on = [google_service_networking_connection.default]
+}
+
+resource "google_compute_network" "network" {
+  name                    = "tf-test-network%{random_suffix}"
+  auto_create_subnetworks = true
+  mtu                     = 8896
+}
+
+resource "google_compute_global_address" "private_ip_alloc" {
+  name          = "tf-test-address%{random_suffix}"
+  purpose       = "VPC_PEERING"
+  address_type  = "INTERNAL"
+  prefix_length = 24
+  network       = google_compute_network.network.id
+}
+
+resource "google_service_networking_connection" "default" {
+  network                 = google_compute_network.network.id
+  service                 = "servicenetworking.googleapis.com"
+  reserved_peering_ranges = [google_compute_global_address.private_ip_alloc.name]
+}
+
+data "google_parallelstore_instances" "filtered" {
+  location = "us-central1-a"
+
+  filters {
+    name   = "name"
+    values = ["/instances/tf-test-instance%{random_suffix}$"]
+  }
+
+  filters {
+    name   = "state"
+    values = ["^ACTIVE$"]
+  }
+
+  depends_on = [google_parallelstore_instance.instance]
+}
+
+data "google_parallelstore_instances" "all_locations" {
+  filters {
+    name   = "name"
+    values = ["/instances/tf-test-instance%{random_suffix}$"]
+  }
+
+  depends_on = [google_parallelstore_instance.instance]
+}
+`, context)
+}
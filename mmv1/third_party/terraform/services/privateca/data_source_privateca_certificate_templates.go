@@ -0,0 +1,145 @@
+package privateca
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourcePrivatecaCertificateTemplates() *schema.Resource {
+	dsSchema := tpgresource.DatasourceSchemaFromResourceSchema(ResourcePrivatecaCertificateTemplate().Schema)
+
+	return &schema.Resource{
+		Read: dataSourcePrivatecaCertificateTemplatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the certificate templates are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The location of the certificate templates. If it is not provided, certificate templates across all locations are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name"),
+			"certificate_templates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the certificate template.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The description of the certificate template.`,
+						},
+						"predefined_values": dsSchema["predefined_values"],
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrivatecaCertificateTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for certificate templates: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	location := "-"
+	if v, ok := d.GetOk("location"); ok {
+		location = v.(string)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{PrivatecaBasePath}}projects/{{project}}/locations/%s/certificateTemplates", location))
+	if err != nil {
+		return err
+	}
+
+	templates := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing certificate templates: %s", err)
+		}
+
+		if items, ok := res["certificateTemplates"].([]interface{}); ok {
+			templates = append(templates, flattenPrivatecaCertificateTemplates(items, d, config)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("certificate_templates", tpgresource.ApplyDatasourceFilters(filters, templates)); err != nil {
+		return fmt.Errorf("Error setting certificate templates: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/certificateTemplates", project, location))
+
+	return nil
+}
+
+func flattenPrivatecaCertificateTemplates(items []interface{}, d *schema.ResourceData, config *transport_tpg.Config) []map[string]interface{} {
+	templates := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		template, ok := item.(map[string]interface{})
+		if !ok || len(template) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		templates = append(templates, map[string]interface{}{
+			"name":              template["name"],
+			"description":       template["description"],
+			"predefined_values": flattenPrivatecaCertificateTemplatePredefinedValues(template["predefinedValues"], d, config),
+		})
+	}
+	return templates
+}
@@ -0,0 +1,103 @@
+package privateca_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourcePrivatecaCertificateTemplates_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckPrivatecaCertificateTemplateDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePrivatecaCertificateTemplates_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_privateca_certificate_templates.filtered", "certificate_templates.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_privateca_certificate_templates.filtered", "certificate_templates.0.name", "google_privateca_certificate_template.server", "id"),
+					resource.TestCheckResourceAttr("data.google_privateca_certificate_templates.filtered", "certificate_templates.0.description", "A server certificate template"),
+					resource.TestCheckResourceAttr("data.google_privateca_certificate_templates.filtered", "certificate_templates.0.predefined_values.0.ca_options.0.is_ca", "false"),
+					resource.TestCheckResourceAttr("data.google_privateca_certificate_templates.filtered", "certificate_templates.0.predefined_values.0.key_usage.0.extended_key_usage.0.server_auth", "true"),
+					resource.TestCheckResourceAttr("data.google_privateca_certificate_templates.all_locations", "certificate_templates.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePrivatecaCertificateTemplates_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_privateca_certificate_template" "server" {
+  location    = "us-central1"
+  name        = "tf-test-server%{random_suffix}"
+  description = "A server certificate template"
+
+  predefined_values {
+    ca_options {
+      is_ca = false
+    }
+    key_usage {
+      base_key_usage {
+        digital_signature = true
+        key_encipherment  = true
+      }
+      extended_key_usage {
+        server_auth = true
+      }
+    }
+  }
+}
+
+resource "google_privateca_certificate_template" "client" {
+  location    = "us-east1"
+  name        = "tf-test-client%{random_suffix}"
+  description = "A client certificate template"
+
+  predefined_values {
+    key_usage {
+      base_key_usage {
+        digital_signature = true
+      }
+      extended_key_usage {
+        client_auth = true
+      }
+    }
+  }
+}
+
+data "google_privateca_certificate_templates" "filtered" {
+  location = "us-central1"
+
+  filters {
+    name   = "name"
+    values = ["/certificateTemplates/tf-test-(server|client)%{random_suffix}$"]
+  }
+
+  depends_on = [
+    google_privateca_certificate_template.server,
+    google_privateca_certificate_template.client,
+  ]
+}
+
+data "google_privateca_certificate_templates" "all_locations" {
+  filters {
+    name   = "name"
+    values = ["/certificateTemplates/tf-test-(server|client)%{random_suffix}$"]
+  }
+
+  depends_on = [
+    google_privateca_certificate_template.server,
+    google_privateca_certificate_template.client,
+  ]
+}
+`, context)
+}
@@ -0,0 +1,263 @@
+package pubsublite
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourcePubsubLiteTopics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePubsubLiteTopicsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the topics are located. If it is not provided, the provider project is used.`,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The region of the topics. If it is not provided, the provider region is used.`,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The zone of the topics. If it is not provided, the regional topics of region are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name"),
+			"topics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the topic.`,
+						},
+						"partition_config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The settings for the partitions of the topic.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: `The number of partitions in the topic.`,
+									},
+									"capacity": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: `The capacity configuration of each partition.`,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"publish_mib_per_sec": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: `The publish throughput capacity per partition in MiB/s.`,
+												},
+												"subscribe_mib_per_sec": {
+													Type:        schema.TypeInt,
+													Computed:    true,
+													Description: `The subscribe throughput capacity per partition in MiB/s.`,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"retention_config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The settings for the message retention of the topic.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"per_partition_bytes": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The provisioned storage, in bytes, per partition.`,
+									},
+									"period": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `How long a published message is retained, for example 3.5s.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePubsubLiteTopicsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Pub/Sub Lite topics: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	region, err := tpgresource.GetRegion(d, config)
+	if err != nil {
+		return err
+	}
+	// Topics are either regional or zonal, and are listed from the regional endpoint.
+	location := region
+	if v, ok := d.GetOk("zone"); ok {
+		location = v.(string)
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, fmt.Sprintf("{{PubsubLiteBasePath}}projects/{{project}}/locations/%s/topics", location))
+	if err != nil {
+		return err
+	}
+
+	topics := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing Pub/Sub Lite topics: %s", err)
+		}
+
+		if items, ok := res["topics"].([]interface{}); ok {
+			topics = append(topics, flattenPubsubLiteTopics(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("topics", tpgresource.ApplyDatasourceFilters(filters, topics)); err != nil {
+		return fmt.Errorf("Error setting Pub/Sub Lite topics: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/topics", project, location))
+
+	return nil
+}
+
+func flattenPubsubLiteTopics(items []interface{}) []map[string]interface{} {
+	topics := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		topic, ok := item.(map[string]interface{})
+		if !ok || len(topic) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		topics = append(topics, map[string]interface{}{
+			"name":             topic["name"],
+			"partition_config": flattenPubsubLiteTopicsPartitionConfig(topic),
+			"retention_config": flattenPubsubLiteTopicsRetentionConfig(topic),
+		})
+	}
+	return topics
+}
+
+func flattenPubsubLiteTopicsPartitionConfig(v map[string]interface{}) interface{} {
+	obj, ok := v["partitionConfig"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"count":    flattenPubsubLiteTopicsPartitionConfigCount(obj),
+			"capacity": flattenPubsubLiteTopicsPartitionConfigCapacity(obj),
+		},
+	}
+}
+
+func flattenPubsubLiteTopicsPartitionConfigCount(v map[string]interface{}) interface{} {
+	// The API returns int64 fields as strings.
+	if n, ok := v["count"].(string); ok {
+		if i, err := tpgresource.StringToFixed64(n); err == nil {
+			return i
+		}
+	}
+	return nil
+}
+
+func flattenPubsubLiteTopicsPartitionConfigCapacity(v map[string]interface{}) interface{} {
+	obj, ok := v["capacity"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"publish_mib_per_sec":   flattenPubsubLiteTopicsPartitionConfigCapacityPublishMibPerSec(obj),
+			"subscribe_mib_per_sec": flattenPubsubLiteTopicsPartitionConfigCapacitySubscribeMibPerSec(obj),
+		},
+	}
+}
+
+func flattenPubsubLiteTopicsPartitionConfigCapacityPublishMibPerSec(v map[string]interface{}) interface{} {
+	if n, ok := v["publishMibPerSec"].(float64); ok {
+		return int(n)
+	}
+	return nil
+}
+
+func flattenPubsubLiteTopicsPartitionConfigCapacitySubscribeMibPerSec(v map[string]interface{}) interface{} {
+	if n, ok := v["subscribeMibPerSec"].(float64); ok {
+		return int(n)
+	}
+	return nil
+}
+
+func flattenPubsubLiteTopicsRetentionConfig(v map[string]interface{}) interface{} {
+	obj, ok := v["retentionConfig"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"per_partition_bytes": obj["perPartitionBytes"],
+			"period":              obj["period"],
+		},
+	}
+}
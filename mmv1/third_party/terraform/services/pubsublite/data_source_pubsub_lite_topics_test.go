@@ -0,0 +1,91 @@
+package pubsublite_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourcePubsubLiteTopics_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckPubsubLiteTopicDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePubsubLiteTopics_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_pubsub_lite_topics.filtered", "topics.#", "1"),
+					resource.TestMatchResourceAttr("data.google_pubsub_lite_topics.filtered", "topics.0.name", regexp.MustCompile("/locations/us-central1-a/topics/tf-test-first-"+context["random_suffix"].(string)+"$")),
+					resource.TestCheckResourceAttr("data.google_pubsub_lite_topics.filtered", "topics.0.partition_config.0.count", "1"),
+					resource.TestCheckResourceAttr("data.google_pubsub_lite_topics.filtered", "topics.0.partition_config.0.capacity.0.publish_mib_per_sec", "4"),
+					resource.TestCheckResourceAttr("data.google_pubsub_lite_topics.filtered", "topics.0.partition_config.0.capacity.0.subscribe_mib_per_sec", "8"),
+					resource.TestCheckResourceAttr("data.google_pubsub_lite_topics.filtered", "topics.0.retention_config.0.per_partition_bytes", "32212254720"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePubsubLiteTopics_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_pubsub_lite_topic" "first" {
+  name   = "tf-test-first-%{random_suffix}"
+  region = "us-central1"
+  zone   = "us-central1-a"
+
+  partition_config {
+    count = 1
+    capacity {
+      publish_mib_per_sec   = 4
+      subscribe_mib_per_sec = 8
+    }
+  }
+
+  retention_config {
+    per_partition_bytes = 32212254720
+  }
+}
+
+resource "google_pubsub_lite_topic" "second" {
+  name   = "tf-test-second-%{random_suffix}"
+  region = "us-central1"
+  zone   = "us-central1-a"
+
+  partition_config {
+    count = 1
+    capacity {
+      publish_mib_per_sec   = 4
+      subscribe_mib_per_sec = 4
+    }
+  }
+
+  retention_config {
+    per_partition_bytes = 32212254720
+  }
+}
+
+data "google_pubsub_lite_topics" "filtered" {
+  region = "us-central1"
+  zone   = "us-central1-a"
+
+  filters {
+    name   = "name"
+    values = ["/topics/tf-test-first-%{random_suffix}$"]
+  }
+
+  depends_on = [
+    google_pubsub_lite_topic.first,
+    google_pubsub_lite_topic.second,
+  ]
+}
+`, context)
+}
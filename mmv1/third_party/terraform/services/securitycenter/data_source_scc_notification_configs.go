@@ -0,0 +1,156 @@
+package securitycenter
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSecurityCenterNotificationConfigs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSecurityCenterNotificationConfigsRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID of the organization whose Security Command Center notification configs are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("description"),
+			"notification_configs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The resource name of the notification config, in the format organizations/{{organization}}/notificationConfigs/{{config_id}}.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The description of the notification config.`,
+						},
+						"pubsub_topic": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The Pub/Sub topic notifications are sent to, in the format projects/{{project}}/topics/{{topic}}.`,
+						},
+						"streaming_config": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: `The config for streaming-based notifications, which sends each event as soon as it is detected.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The expression selecting the findings notifications are sent for.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecurityCenterNotificationConfigsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{SecurityCenterBasePath}}organizations/{{organization}}/notificationConfigs")
+	if err != nil {
+		return err
+	}
+
+	notificationConfigs := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing notification configs: %s", err)
+		}
+
+		if items, ok := res["notificationConfigs"].([]interface{}); ok {
+			notificationConfigs = append(notificationConfigs, flattenSecurityCenterNotificationConfigs(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("notification_configs", tpgresource.ApplyDatasourceFilters(filters, notificationConfigs)); err != nil {
+		return fmt.Errorf("Error setting notification configs: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("organizations/%s/notificationConfigs", d.Get("organization").(string)))
+
+	return nil
+}
+
+func flattenSecurityCenterNotificationConfigs(items []interface{}) []map[string]interface{} {
+	notificationConfigs := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		notificationConfig, ok := item.(map[string]interface{})
+		if !ok || len(notificationConfig) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		notificationConfigs = append(notificationConfigs, map[string]interface{}{
+			"name":             notificationConfig["name"],
+			"description":      notificationConfig["description"],
+			"pubsub_topic":     notificationConfig["pubsubTopic"],
+			"streaming_config": flattenSecurityCenterNotificationConfigsStreamingConfig(notificationConfig),
+		})
+	}
+	return notificationConfigs
+}
+
+func flattenSecurityCenterNotificationConfigsStreamingConfig(v map[string]interface{}) interface{} {
+	obj, ok := v["streamingConfig"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"filter": obj["filter"],
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package securitycenter_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccDataSourceSecurityCenterNotificationConfigs_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":        envvar.GetTestOrgFromEnv(t),
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckSecurityCenterNotificationConfigDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityCenterNotificationConfigs_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_scc_notification_configs.filtered", "notification_configs.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_scc_notification_configs.filtered", "notification_configs.0.name", "google_scc_notification_config.firewall", "name"),
+					resource.TestCheckResourceAttrPair("data.google_scc_notification_configs.filtered", "notification_configs.0.pubsub_topic", "google_pubsub_topic.scc_notification", "id"),
+					resource.TestCheckResourceAttr("data.google_scc_notification_configs.filtered", "notification_configs.0.streaming_config.0.filter", "category = \"OPEN_FIREWALL\""),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecurityCenterNotificationConfigs_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_pubsub_topic" "scc_notification" {
+  name = "tf-test-my-topic%{random_suffix}"
+}
+
+resource "google_scc_notification_config" "firewall" {
+  config_id    = "tf-test-firewall%{random_suffix}"
+  organization = "%{org_id}"
+  description  = "tf-test-%{random_suffix} open firewall findings"
+  pubsub_topic = google_pubsub_topic.scc_notification.id
+
+  streaming_config {
+    filter = "category = \"OPEN_FIREWALL\""
+  }
+}
+
+resource "google_scc_notification_config" "bucket" {
+  config_id    = "tf-test-bucket%{random_suffix}"
+  organization = "%{org_id}"
+  description  = "tf-test-%{random_suffix} public bucket findings"
+  pubsub_topic = google_pubsub_topic.scc_notification.id
+
+  streaming_config {
+    filter = "category = \"PUBLIC_BUCKET_ACL\""
+  }
+}
+
+data "google_scc_notification_configs" "filtered" {
+  organization = "%{org_id}"
+
+  filters {
+    name   = "description"
+    values = ["^tf-test-%{random_suffix} .*firewall"]
+  }
+
+  depends_on = [
+    google_scc_notification_config.firewall,
+    google_scc_notification_config.bucket,
+  ]
+}
+`, context)
+}
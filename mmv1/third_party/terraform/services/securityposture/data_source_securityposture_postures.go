@@ -0,0 +1,146 @@
+package securityposture
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceSecurityposturePostures() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSecurityposturePosturesRead,
+
+		Schema: map[string]*schema.Schema{
+			"parent": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The organization of the postures, in the format organizations/{organization_id}.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "global",
+				Description: `The location of the postures. Defaults to global.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "state"),
+			"postures": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the posture.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The state of the posture, one of DRAFT, ACTIVE or DEPRECATED.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The description of the posture.`,
+						},
+						"policy_set_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of policy sets in the posture.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecurityposturePosturesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{SecuritypostureBasePath}}{{parent}}/locations/{{location}}/postures")
+	if err != nil {
+		return err
+	}
+
+	postures := make([]map[string]interface{}, 0)
+	params := make(map[string]string)
+	for {
+		listUrl, err := transport_tpg.AddQueryParams(url, params)
+		if err != nil {
+			return err
+		}
+
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			Project:   billingProject,
+			RawURL:    listUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return fmt.Errorf("Error listing security postures: %s", err)
+		}
+
+		if items, ok := res["postures"].([]interface{}); ok {
+			postures = append(postures, flattenSecurityposturePostures(items)...)
+		}
+
+		token, ok := res["nextPageToken"].(string)
+		if !ok || token == "" {
+			break
+		}
+		params["pageToken"] = token
+	}
+
+	if err := d.Set("postures", tpgresource.ApplyDatasourceFilters(filters, postures)); err != nil {
+		return fmt.Errorf("Error setting security postures: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/locations/%s/postures", d.Get("parent").(string), d.Get("location").(string)))
+
+	return nil
+}
+
+func flattenSecurityposturePostures(items []interface{}) []map[string]interface{} {
+	postures := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		posture, ok := item.(map[string]interface{})
+		if !ok || len(posture) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		postures = append(postures, map[string]interface{}{
+			"name":             posture["name"],
+			"state":            posture["state"],
+			"description":      posture["description"],
+			"policy_set_count": flattenSecurityposturePosturesPolicySetCount(posture),
+		})
+	}
+	return postures
+}
+
+func flattenSecurityposturePosturesPolicySetCount(v map[string]interface{}) interface{} {
+	policySets, _ := v["policySets"].([]interface{})
+	return len(policySets)
+}
@@ -0,0 +1,92 @@
+package securityposture_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+	"github.com/hashicorp/terraform-provider-google/google/envvar"
+)
+
+func TestAccDataSourceSecurityposturePostures_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":        envvar.GetTestOrgTargetFromEnv(t),
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckSecurityposturePostureDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecurityposturePostures_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_securityposture_postures.filtered", "postures.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_securityposture_postures.filtered", "postures.0.name", "google_securityposture_posture.posture", "name"),
+					resource.TestCheckResourceAttr("data.google_securityposture_postures.filtered", "postures.0.state", "DRAFT"),
+					resource.TestCheckResourceAttr("data.google_securityposture_postures.filtered", "postures.0.description", "a draft posture"),
+					resource.TestCheckResourceAttr("data.google_securityposture_postures.filtered", "postures.0.policy_set_count", "1"),
+					resource.TestCheckResourceAttr("data.google_securityposture_postures.active", "postures.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecurityposturePostures_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_securityposture_posture" "posture" {
+  posture_id  = "tf_test_posture_%{random_suffix}"
+  parent      = "organizations/%{org_id}"
+  location    = "global"
+  state       = "DRAFT"
+  description = "a draft posture"
+
+  policy_sets {
+    policy_set_id = "org_policy_set"
+    description   = "set of org policies"
+    policies {
+      policy_id = "policy_1"
+      constraint {
+        org_policy_constraint {
+          canned_constraint_id = "storage.uniformBucketLevelAccess"
+          policy_rules {
+            enforce = true
+          }
+        }
+      }
+    }
+  }
+}
+
+data "google_securityposture_postures" "filtered" {
+  parent = "organizations/%{org_id}"
+
+  filters {
+    name   = "name"
+    values = ["/postures/tf_test_posture_%{random_suffix}$"]
+  }
+
+  depends_on = [google_securityposture_posture.posture]
+}
+
+data "google_securityposture_postures" "active" {
+  parent = "organizations/%{org_id}"
+
+  filters {
+    name   = "name"
+    values = ["/postures/tf_test_posture_%{random_suffix}$"]
+  }
+
+  filters {
+    name   = "state"
+    values = ["^ACTIVE$"]
+  }
+
+  depends_on = [google_securityposture_posture.posture]
+}
+`, context)
+}
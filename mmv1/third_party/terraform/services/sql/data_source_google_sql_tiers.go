@@ -22,6 +22,7 @@ func DataSourceGoogleSQLTiers() *schema.Resource {
 				ForceNew:    true,
 				Description: `Project ID of the project for which to list tiers.`,
 			},
+			"filters": tpgresource.DatasourceFiltersSchema("tier", "region"),
 			"tiers": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -69,6 +70,11 @@ func dataSourceGoogleSQLTiersRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Fetching tiers for project %s", project)
 
 	response, err := config.NewSqlAdminClient(userAgent).Tiers.List(project).Do()
@@ -81,7 +87,7 @@ func dataSourceGoogleSQLTiersRead(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("project", project); err != nil {
 		return fmt.Errorf("error setting project: %s", err)
 	}
-	if err := d.Set("tiers", flattenTiers(response.Items)); err != nil {
+	if err := d.Set("tiers", tpgresource.ApplyDatasourceFilters(filters, flattenTiers(response.Items))); err != nil {
 		return fmt.Errorf("error setting tiers: %s", err)
 	}
 
@@ -25,6 +25,30 @@ func TestAccDataSourceGoogleSqlTiers_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "tiers.0.ram"),
 					resource.TestCheckResourceAttrSet(resourceName, "tiers.0.disk_quota"),
 					resource.TestCheckResourceAttrSet(resourceName, "tiers.0.region.0"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tiers.*", map[string]string{
+						"tier": "db-f1-micro",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceGoogleSqlTiers_filters(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.google_sql_tiers.filtered"
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGoogleSqlTiers_filters,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tiers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tiers.0.tier", "db-f1-micro"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tiers.0.region.*", "us-central1"),
 				),
 			},
 		},
@@ -35,3 +59,17 @@ const testAccCheckGoogleSqlTiers_basic = `
 data "google_sql_tiers" "default" {
 }
 `
+
+const testAccCheckGoogleSqlTiers_filters = `
+data "google_sql_tiers" "filtered" {
+  filters {
+    name   = "tier"
+    values = ["^db-f1-micro$"]
+  }
+
+  filters {
+    name   = "region"
+    values = ["^us-central1$"]
+  }
+}
+`
@@ -0,0 +1,112 @@
+package sql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/googleapi"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+)
+
+func DataSourceSqlDatabaseInstanceFlags() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSqlDatabaseInstanceFlagsRead,
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `The name of the instance.`,
+			},
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the instance belongs.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name"),
+			"flags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the flag.`,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The value of the flag, empty for flags that take no value.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSqlDatabaseInstanceFlagsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+	fv, err := tpgresource.ParseProjectFieldValue("instances", d.Get("instance").(string), "project", d, config, false)
+	if err != nil {
+		return err
+	}
+	project := fv.Project
+	instance := fv.Name
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	// Only the flags are requested, as the rest of the instance is not exported.
+	var inst *sqladmin.DatabaseInstance
+	err = transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() (rerr error) {
+			inst, rerr = config.NewSqlAdminClient(userAgent).Instances.Get(project, instance).Fields(googleapi.Field("settings/databaseFlags")).Do()
+			return rerr
+		},
+		Timeout:              5 * time.Minute,
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsSqlOperationInProgressError},
+	})
+	if err != nil {
+		return transport_tpg.HandleDataSourceNotFoundError(err, d, fmt.Sprintf("Instance %q", instance), fmt.Sprintf("Instance %q", instance))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("flags", tpgresource.ApplyDatasourceFilters(filters, flattenSqlDatabaseInstanceFlags(inst.Settings))); err != nil {
+		return fmt.Errorf("Error setting flags: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/instances/%s/flags", project, instance))
+	return nil
+}
+
+// flattenSqlDatabaseInstanceFlags returns the database flags set on an
+// instance, in the order the API returns them.
+func flattenSqlDatabaseInstanceFlags(settings *sqladmin.Settings) []map[string]interface{} {
+	flags := make([]map[string]interface{}, 0)
+	if settings == nil {
+		return flags
+	}
+	for _, flag := range settings.DatabaseFlags {
+		if flag == nil {
+			continue
+		}
+		flags = append(flags, map[string]interface{}{
+			"name":  flag.Name,
+			"value": flag.Value,
+		})
+	}
+	return flags
+}
@@ -0,0 +1,77 @@
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceSqlDatabaseInstanceFlags_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSqlDatabaseInstanceFlags_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.google_sql_database_instance_flags.all", "project"),
+					resource.TestCheckResourceAttr("data.google_sql_database_instance_flags.all", "flags.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_sql_database_instance_flags.all", "flags.*", map[string]string{
+						"name":  "log_min_duration_statement",
+						"value": "1000",
+					}),
+					resource.TestCheckResourceAttr("data.google_sql_database_instance_flags.logging", "flags.#", "1"),
+					resource.TestCheckResourceAttr("data.google_sql_database_instance_flags.logging", "flags.0.name", "log_connections"),
+					resource.TestCheckResourceAttr("data.google_sql_database_instance_flags.logging", "flags.0.value", "on"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSqlDatabaseInstanceFlags_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "main" {
+  name             = "tf-test-instance-%{random_suffix}"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+
+    database_flags {
+      name  = "log_connections"
+      value = "on"
+    }
+
+    database_flags {
+      name  = "log_min_duration_statement"
+      value = "1000"
+    }
+  }
+
+  deletion_protection = false
+}
+
+data "google_sql_database_instance_flags" "all" {
+  instance = google_sql_database_instance.main.name
+}
+
+data "google_sql_database_instance_flags" "logging" {
+  instance = google_sql_database_instance.main.name
+
+  filters {
+    name   = "name"
+    values = ["^log_conn"]
+  }
+}
+`, context)
+}
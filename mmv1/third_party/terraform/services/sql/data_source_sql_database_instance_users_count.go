@@ -0,0 +1,75 @@
+package sql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/googleapi"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+)
+
+func DataSourceSqlDatabaseInstanceUsersCount() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSqlDatabaseInstanceUsersCountRead,
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `The name of the instance.`,
+			},
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the instance belongs.`,
+			},
+			"users_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The number of users of the instance, including the users the database engine creates by default.`,
+			},
+		},
+	}
+}
+
+func dataSourceSqlDatabaseInstanceUsersCountRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+	fv, err := tpgresource.ParseProjectFieldValue("instances", d.Get("instance").(string), "project", d, config, false)
+	if err != nil {
+		return err
+	}
+	project := fv.Project
+	instance := fv.Name
+
+	// Only the user names are requested, so the response stays small however
+	// many users the instance has.
+	var users *sqladmin.UsersListResponse
+	err = transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() (rerr error) {
+			users, rerr = config.NewSqlAdminClient(userAgent).Users.List(project, instance).Fields(googleapi.Field("items/name")).Do()
+			return rerr
+		},
+		Timeout:              5 * time.Minute,
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsSqlOperationInProgressError},
+	})
+	if err != nil {
+		return transport_tpg.HandleDataSourceNotFoundError(err, d, fmt.Sprintf("Users in %q instance", instance), fmt.Sprintf("Users in %q instance", instance))
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("users_count", len(users.Items)); err != nil {
+		return fmt.Errorf("Error setting users_count: %s", err)
+	}
+	d.SetId(fmt.Sprintf("projects/%s/instances/%s/users", project, instance))
+	return nil
+}
@@ -0,0 +1,69 @@
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceSqlDatabaseInstanceUsersCount_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+	resourceName := "data.google_sql_database_instance_users_count.default"
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSqlDatabaseInstanceUsersCount_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "project"),
+					// The two users created below, plus the default postgres user.
+					resource.TestCheckResourceAttr(resourceName, "users_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSqlDatabaseInstanceUsersCount_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "main" {
+  name             = "tf-test-instance-%{random_suffix}"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_user" "user1" {
+  name     = "tf-test-user1-%{random_suffix}"
+  instance = google_sql_database_instance.main.name
+  password = "password"
+}
+
+resource "google_sql_user" "user2" {
+  name     = "tf-test-user2-%{random_suffix}"
+  instance = google_sql_database_instance.main.name
+  password = "password"
+}
+
+data "google_sql_database_instance_users_count" "default" {
+  instance = google_sql_database_instance.main.name
+  depends_on = [
+    google_sql_user.user1,
+    google_sql_user.user2,
+  ]
+}
+`, context)
+}
@@ -46,17 +46,41 @@ func DataSourceSqlDatabaseInstances() *schema.Resource {
 				Optional:    true,
 				Description: `To filter out the database instances based on the current state of the database instance, valid values include : "SQL_INSTANCE_STATE_UNSPECIFIED", "RUNNABLE", "SUSPENDED", "PENDING_DELETE", "PENDING_CREATE", "MAINTENANCE" and "FAILED".`,
 			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "availability_type", "maintenance_window_day", "maintenance_window_hour"),
 			"instances": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: tpgresource.DatasourceSchemaFromResourceSchema(ResourceSqlDatabaseInstance().Schema),
+					Schema: sqlDatabaseInstancesElemSchema(),
 				},
 			},
 		},
 	}
 }
 
+// sqlDatabaseInstancesElemSchema returns the schema of each entry in
+// instances: the google_sql_database_instance attributes, plus the settings
+// operators filter on when planning maintenance, exported at the top level.
+func sqlDatabaseInstancesElemSchema() map[string]*schema.Schema {
+	elemSchema := tpgresource.DatasourceSchemaFromResourceSchema(ResourceSqlDatabaseInstance().Schema)
+	elemSchema["availability_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: `The availability type of the instance, ZONAL or REGIONAL.`,
+	}
+	elemSchema["maintenance_window_day"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: `The day of week of the maintenance window, from 1 for Monday to 7 for Sunday, or 0 when no window is set.`,
+	}
+	elemSchema["maintenance_window_hour"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: `The hour of day, from 0 to 23 in UTC, at which the maintenance window starts.`,
+	}
+	return elemSchema
+}
+
 func dataSourceSqlDatabaseInstancesRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
@@ -67,6 +91,10 @@ func dataSourceSqlDatabaseInstancesRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return err
 	}
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
 	filter := ""
 
 	if v, ok := d.GetOk("database_version"); ok {
@@ -121,7 +149,7 @@ func dataSourceSqlDatabaseInstancesRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if err := d.Set("instances", databaseInstances); err != nil {
+	if err := d.Set("instances", tpgresource.ApplyDatasourceFilters(filters, databaseInstances)); err != nil {
 		return fmt.Errorf("Error retrieving instances: %s", err)
 	}
 
@@ -147,6 +175,16 @@ func flattenDatasourceGoogleDatabaseInstancesList(fetchedInstances []*sqladmin.D
 		instance["instance_type"] = rawInstance.InstanceType
 		instance["service_account_email_address"] = rawInstance.ServiceAccountEmailAddress
 		instance["settings"] = flattenSettings(rawInstance.Settings, rawInstance.InstanceType, d)
+		instance["availability_type"] = ""
+		instance["maintenance_window_day"] = 0
+		instance["maintenance_window_hour"] = 0
+		if rawInstance.Settings != nil {
+			instance["availability_type"] = rawInstance.Settings.AvailabilityType
+			if rawInstance.Settings.MaintenanceWindow != nil {
+				instance["maintenance_window_day"] = rawInstance.Settings.MaintenanceWindow.Day
+				instance["maintenance_window_hour"] = rawInstance.Settings.MaintenanceWindow.Hour
+			}
+		}
 
 		if rawInstance.DiskEncryptionConfiguration != nil {
 			instance["encryption_key_name"] = rawInstance.DiskEncryptionConfiguration.KmsKeyName
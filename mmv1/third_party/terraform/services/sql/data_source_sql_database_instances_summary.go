@@ -0,0 +1,156 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+)
+
+func DataSourceSqlDatabaseInstancesSummary() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSqlDatabaseInstancesSummaryRead,
+
+		// The read timeout bounds the whole read, including retrying list calls.
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project whose instances are summarized. If it is not provided, the provider project is used.`,
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  `The maximum number of instances whose databases are listed concurrently.`,
+			},
+			"include_system_databases": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Count the system databases of each engine, such as postgres or mysql, which are not counted by default.`,
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the instance.`,
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The region of the instance.`,
+						},
+						"databases_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `The number of databases of the instance.`,
+						},
+					},
+				},
+			},
+			"total_databases": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The number of databases across every instance.`,
+			},
+		},
+	}
+}
+
+func dataSourceSqlDatabaseInstancesSummaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	timeout := d.Timeout(schema.TimeoutRead)
+
+	instances, err := transport_tpg.ListAllPages(func(pageToken string) ([]*sqladmin.DatabaseInstance, string, error) {
+		res, err := config.NewSqlAdminClient(userAgent).Instances.List(project).PageToken(pageToken).Context(ctx).Do()
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Items, res.NextPageToken, nil
+	}, func(retryFunc func() error) transport_tpg.RetryOptions {
+		return sqlDatabasesRetryOptions(ctx, retryFunc, timeout, false)
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error listing instances in project %q: %s", project, err))
+	}
+
+	names := make([]string, 0, len(instances))
+	listed := make(map[string]*sqladmin.DatabaseInstance, len(instances))
+	for _, instance := range instances {
+		names = append(names, instance.Name)
+		listed[instance.Name] = instance
+	}
+	sort.Strings(names)
+
+	databases, _, err := listSqlDatabasesAcrossInstances(ctx, names, d.Get("max_concurrency").(int), false, func(ctx context.Context, instance string) ([]*sqladmin.Database, error) {
+		databases, err := listSqlDatabases(ctx, config, userAgent, project, instance, "", timeout, false)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing databases in instance %q: %s", instance, err)
+		}
+		return databases, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !d.Get("include_system_databases").(bool) {
+		databases = excludeSqlSystemDatabases(databases, listed)
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting project: %s", err))
+	}
+	if err := d.Set("instances", flattenSqlInstancesSummary(names, listed, databases)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instances: %s", err))
+	}
+	if err := d.Set("total_databases", len(databases)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting total_databases: %s", err))
+	}
+	d.SetId(fmt.Sprintf("project/%s/instances/summary", project))
+
+	return nil
+}
+
+// flattenSqlInstancesSummary returns an entry for every instance in names,
+// including the instances without databases, with the number of databases
+// of the instance.
+func flattenSqlInstancesSummary(names []string, instances map[string]*sqladmin.DatabaseInstance, databases []*sqladmin.Database) []map[string]interface{} {
+	counts := make(map[string]int, len(names))
+	for _, database := range databases {
+		counts[database.Instance]++
+	}
+
+	summary := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		summary = append(summary, map[string]interface{}{
+			"instance":        name,
+			"region":          instances[name].Region,
+			"databases_count": counts[name],
+		})
+	}
+	return summary
+}
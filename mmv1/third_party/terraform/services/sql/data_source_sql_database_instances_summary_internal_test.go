@@ -0,0 +1,77 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+)
+
+func TestDataSourceSqlDatabaseInstancesSummary(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/projects/project/instances"):
+			fmt.Fprint(w, `{"items": [{"name": "orders", "region": "us-east1", "databaseVersion": "POSTGRES_15"}, {"name": "billing", "region": "us-central1", "databaseVersion": "POSTGRES_15"}]}`)
+		case strings.HasSuffix(r.URL.Path, "/projects/project/instances/billing/databases"):
+			fmt.Fprint(w, `{"items": [{"name": "postgres", "instance": "billing", "project": "project"}, {"name": "invoices", "instance": "billing", "project": "project"}, {"name": "ledger", "instance": "billing", "project": "project"}]}`)
+		case strings.HasSuffix(r.URL.Path, "/projects/project/instances/orders/databases"):
+			fmt.Fprint(w, `{"items": [{"name": "postgres", "instance": "orders", "project": "project"}, {"name": "orders", "instance": "orders", "project": "project"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 404, "message": "not found"}}`)
+		}
+	}))
+	defer ts.Close()
+
+	config := &transport_tpg.Config{
+		Project:     "project",
+		SQLBasePath: ts.URL + "/",
+		Client:      ts.Client(),
+		Context:     context.Background(),
+	}
+
+	cases := map[string]struct {
+		Config   map[string]interface{}
+		Expected []interface{}
+		Total    int
+	}{
+		"without system databases": {
+			Config: map[string]interface{}{},
+			Expected: []interface{}{
+				map[string]interface{}{"instance": "billing", "region": "us-central1", "databases_count": 2},
+				map[string]interface{}{"instance": "orders", "region": "us-east1", "databases_count": 1},
+			},
+			Total: 3,
+		},
+		"with system databases": {
+			Config: map[string]interface{}{"include_system_databases": true},
+			Expected: []interface{}{
+				map[string]interface{}{"instance": "billing", "region": "us-central1", "databases_count": 3},
+				map[string]interface{}{"instance": "orders", "region": "us-east1", "databases_count": 2},
+			},
+			Total: 5,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, DataSourceSqlDatabaseInstancesSummary().Schema, tc.Config)
+			if diags := dataSourceSqlDatabaseInstancesSummaryRead(context.Background(), d, config); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got := d.Get("instances").([]interface{}); !reflect.DeepEqual(got, tc.Expected) {
+				t.Errorf("expected instances %v, got %v", tc.Expected, got)
+			}
+			if got := d.Get("total_databases").(int); got != tc.Total {
+				t.Errorf("expected total_databases %d, got %d", tc.Total, got)
+			}
+		})
+	}
+}
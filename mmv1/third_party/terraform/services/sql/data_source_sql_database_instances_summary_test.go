@@ -0,0 +1,92 @@
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceSqlDatabaseInstancesSummary_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+	resourceName := "data.google_sql_database_instances_summary.default"
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSqlDatabaseInstancesSummary_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "total_databases"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instances.*", map[string]string{
+						"instance":        "tf-test-summary-" + context["random_suffix"].(string) + "-a",
+						"region":          "us-central1",
+						"databases_count": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instances.*", map[string]string{
+						"instance":        "tf-test-summary-" + context["random_suffix"].(string) + "-b",
+						"region":          "us-east1",
+						"databases_count": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSqlDatabaseInstancesSummary_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "a" {
+  name             = "tf-test-summary-%{random_suffix}-a"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database_instance" "b" {
+  name             = "tf-test-summary-%{random_suffix}-b"
+  database_version = "POSTGRES_14"
+  region           = "us-east1"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database" "a1" {
+  instance = google_sql_database_instance.a.name
+  name     = "pg-app"
+}
+
+resource "google_sql_database" "a2" {
+  instance = google_sql_database_instance.a.name
+  name     = "pg-audit"
+}
+
+resource "google_sql_database" "b1" {
+  instance = google_sql_database_instance.b.name
+  name     = "pg-app"
+}
+
+data "google_sql_database_instances_summary" "default" {
+  depends_on = [
+    google_sql_database.a1,
+    google_sql_database.a2,
+    google_sql_database.b1
+  ]
+}
+`, context)
+}
@@ -132,6 +132,32 @@ func TestAccDataSourceSqlDatabaseInstances_tierFilter(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceSqlDatabaseInstances_availabilityTypeFilter(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSqlDatabaseInstances_availabilityTypeFilter(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_sql_database_instances.regional", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_sql_database_instances.regional", "instances.0.name", "google_sql_database_instance.regional", "name"),
+					resource.TestCheckResourceAttr("data.google_sql_database_instances.regional", "instances.0.availability_type", "REGIONAL"),
+					resource.TestCheckResourceAttr("data.google_sql_database_instances.regional", "instances.0.maintenance_window_day", "7"),
+					resource.TestCheckResourceAttr("data.google_sql_database_instances.regional", "instances.0.maintenance_window_hour", "3"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceSqlDatabaseInstances_basic(context map[string]interface{}) string {
 	return acctest.Nprintf(`
 resource "google_sql_database_instance" "main" {
@@ -418,3 +444,65 @@ func checkFieldsMatchForDataSourceStateAndResourceState(dsAttr, rsAttr map[strin
 
 	return nil
 }
+
+func testAccDataSourceSqlDatabaseInstances_availabilityTypeFilter(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "regional" {
+  name             = "tf-test-instance-regional-%{random_suffix}"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier              = "db-custom-1-3840"
+    availability_type = "REGIONAL"
+
+    backup_configuration {
+      enabled                        = true
+      point_in_time_recovery_enabled = true
+    }
+
+    maintenance_window {
+      day  = 7
+      hour = 3
+    }
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database_instance" "zonal" {
+  name             = "tf-test-instance-zonal-%{random_suffix}"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier              = "db-f1-micro"
+    availability_type = "ZONAL"
+  }
+
+  deletion_protection = false
+}
+
+data "google_sql_database_instances" "regional" {
+  filters {
+    name   = "name"
+    values = ["-%{random_suffix}$"]
+  }
+
+  filters {
+    name           = "availability_type"
+    literal_values = ["REGIONAL"]
+  }
+
+  filters {
+    name           = "maintenance_window_day"
+    literal_values = ["6", "7"]
+  }
+
+  depends_on = [
+    google_sql_database_instance.regional,
+    google_sql_database_instance.zonal
+  ]
+}
+`, context)
+}
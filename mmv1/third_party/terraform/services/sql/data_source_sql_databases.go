@@ -1,21 +1,67 @@
 package sql
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"log"
+	"net/url"
+	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
 	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"github.com/hashicorp/terraform-provider-google/google/verify"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/api/googleapi"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 )
 
+// sqlDatabaseFields are the attributes of each entry in databases that the
+// fields argument can select.
+var sqlDatabaseFields = []string{"name", "charset", "collation", "self_link", "project", "instance", "etag", "kind", "region"}
+
+// sqlDatabasesJSONFields are the attributes of each database encoded in
+// databases_json.
+var sqlDatabasesJSONFields = []string{"name", "charset", "collation", "self_link", "instance", "project"}
+
+// sqlSystemDatabases are the system databases of each database engine, keyed
+// by the prefix of the database_version of the instances running it.
+var sqlSystemDatabases = map[string][]string{
+	"MYSQL_":     {"information_schema", "mysql", "performance_schema", "sys"},
+	"POSTGRES_":  {"postgres", "template0", "template1"},
+	"SQLSERVER_": {"master", "model", "msdb", "tempdb"},
+}
+
+// sqlCharsetFamilies maps the families accepted by charset_family to the
+// charset regular expression they expand to. The match is case-insensitive as
+// MySQL reports charsets in lower case and PostgreSQL in upper case.
+var sqlCharsetFamilies = map[string]string{
+	"utf8":  `(?i)^utf8(mb3|mb4)?$`,
+	"utf16": `(?i)^utf16(le)?$`,
+	"latin": `(?i)^latin[0-9]+$`,
+}
+
+// sqlInstanceRunnablePollInterval is how often require_runnable checks the
+// state of an instance that is not RUNNABLE yet.
+const sqlInstanceRunnablePollInterval = 10 * time.Second
+
 func DataSourceSqlDatabases() *schema.Resource {
 
 	return &schema.Resource{
-		Read: dataSourceSqlDatabasesRead,
+		ReadContext: dataSourceSqlDatabasesRead,
+
+		// The read timeout bounds the whole read, including waiting for
+		// require_runnable and retrying list calls.
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"project": {
@@ -24,73 +70,1255 @@ func DataSourceSqlDatabases() *schema.Resource {
 				Description: `Project ID of the project that contains the instance.`,
 			},
 			"instance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"instance", "instance_regex"},
+				Description:  `The name or self_link of the Cloud SQL database instance in which the database belongs. The project of a self_link takes precedence over project.`,
+			},
+			"validate_instance": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Report an instance that does not exist as not found in the project. Only applies to instance.`,
+			},
+			"instance_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidateRegexCompiles(),
+				Description:  `A regular expression matched against the names of the Cloud SQL database instances in the project. Databases are listed across every matching instance.`,
+			},
+			"label_filters": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"instance"},
+				Description:   `Select the instances matched by instance_regex by the value of their user labels. An instance is queried only if it satisfies every label_filters block.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The key of the user label.`,
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: verify.ValidateRegexCompiles()},
+							Description: `A list of regular expressions. An instance satisfies the block if it has the label and its value matches any of them.`,
+						},
+					},
+				},
+			},
+			"max_instances": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     50,
+				Description: `The maximum number of instances instance_regex may match. Reading the data source fails if more instances match.`,
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  `The maximum number of instances whose databases are listed concurrently.`,
+			},
+			"database": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filters", "instance_regex", "charset_family"},
+				Description:   `The name of a single database to read. When set, only that database is returned and its attributes are also exported at the top level.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "charset", "collation", "collation_family", "instance", "region"),
+			"charset_family": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"utf8", "utf16", "latin"}, false),
+				Description:  `A charset family, one of utf8, utf16 or latin. Only databases whose charset belongs to the family are returned, in addition to any charset filters.`,
+			},
+			"retry_on_rate_limit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `When retrying list calls rejected by the Cloud SQL Admin API rate limit, wait for the delay advised by the Retry-After header when there is one. Retries stop at the read timeout.`,
+			},
+			"require_runnable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Wait, within the read timeout, for each instance to be RUNNABLE before listing its databases. Useful right after the instance is created.`,
+			},
+			"include_system_databases": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: `Include the system databases of the database engine of each instance, such as mysql and sys on MySQL or postgres and template1 on PostgreSQL.`,
+			},
+			"continue_on_error": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Return the databases of the instances that could be listed instead of failing when listing the databases of some instances fails. The failures are reported in errors.`,
+			},
+			"warn_on_empty": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Emit a warning, including a summary of the filters, when databases were listed but none of them matched the filters.`,
+			},
+			"debug": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: `Export the URL of the request listing the databases in request_url, to help troubleshoot filters.`,
+			},
+			"fields": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(sqlDatabaseFields, false),
+				},
+				Description: `The attributes to populate for each entry in databases. Attributes that are not listed are left empty. Defaults to all attributes. Leaving out region skips getting the instance unless a filter or another option needs it, in which case the instance attributes are left empty.`,
+			},
+			"order_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "name",
+				ValidateFunc: validation.StringInSlice(sqlDatabasesOrderByKeys(), false),
+				Description:  `The attribute databases are ordered by, one of name, charset or collation. Databases with the same value are then ordered by name and instance.`,
+			},
+			"dedupe_by": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{"name"}, false),
+				ConflictsWith: []string{"database"},
+				Description:   `Keep only the first of the databases that share this attribute once filters are applied. Only name is supported. The first database in the order set by order_by is kept, which with the default order is the database of the first instance in alphabetical order.`,
+			},
+			"limit": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntAtLeast(0),
+				ConflictsWith: []string{"database"},
+				Description:   `The maximum number of databases to return once filters and dedupe_by are applied. The first databases in the order set by order_by are kept. 0 means no limit.`,
+			},
+			"expected_charset": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The charset databases are expected to use. Databases with a different charset are listed in nonconforming_databases.`,
+			},
+			"expected_collation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The collation databases are expected to use. Databases with a different collation are listed in nonconforming_databases.`,
+			},
+			"charset": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The charset of the database selected by database.`,
+			},
+			"collation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The collation of the database selected by database.`,
+			},
+			"self_link": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The URI of the database selected by database.`,
+			},
+			"connection_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The connection name of the instance, in the format project:region:instance, as used by the Cloud SQL Auth Proxy. Only set when instance is.`,
+			},
+			"instance_create_time": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: `The name of the Cloud SQL database instance in which the database belongs.`,
+				Computed:    true,
+				Description: `The time the instance was created, in RFC3339 format. Databases do not expose a creation time, so this bounds the age of every database of the instance. Only set when instance is.`,
+			},
+			"instance_region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The region of the instance. Only set when instance is.`,
+			},
+			"instance_tier": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The machine type of the instance, such as db-f1-micro. Only set when instance is.`,
+			},
+			"public_ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The first public (PRIMARY) IPv4 address of the instance. Only set when instance is.`,
+			},
+			"private_ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The first private (PRIVATE) IPv4 address of the instance. Only set when instance is.`,
+			},
+			"require_ssl": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Whether the instance only accepts SSL connections, either through its ssl_mode or the legacy require_ssl setting. Only set when instance is.`,
 			},
 			"databases": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
-					Schema: tpgresource.DatasourceSchemaFromResourceSchema(ResourceSQLDatabase().Schema),
+					Schema: sqlDatabasesElemSchema(),
+				},
+			},
+			"databases_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The JSON encoding of the databases in databases, as an array of objects with the name, charset, collation, self_link, instance and project of each database.`,
+			},
+			"databases_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The number of databases in databases.`,
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The names of the databases in databases, in the same order. A name is empty when fields leaves out name.`,
+			},
+			"self_links": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The self_links of the databases in databases, in the same order. A self_link is empty when fields leaves out self_link.`,
+			},
+			"nonconforming_databases": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The names of the databases in databases whose charset or collation differs from expected_charset or expected_collation. A name found on several instances is given as instance/name instead.`,
+			},
+			"filters_applied": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Whether any filters block, or charset_family, was evaluated against the listed databases. Filters are not evaluated when database is set.`,
+			},
+			"request_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The URL of the request listing the databases, including any server-side filter. With instance_regex, the request for the first matched instance. Only set when debug is.`,
+			},
+			"errors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The instances whose databases could not be listed when continue_on_error is set.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the instance.`,
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The error returned while listing the databases of the instance.`,
+						},
+					},
 				},
 			},
+			"databases_map": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				// The SDK cannot represent a map of objects, so each value is the
+				// JSON encoding of the matching entry in databases.
+				Description: `The databases in databases keyed by name, with each value being the JSON encoding of the database. Names found on several instances are keyed by instance/name instead.`,
+			},
 		},
 	}
 }
 
-func dataSourceSqlDatabasesRead(d *schema.ResourceData, meta interface{}) error {
+// sqlDatabasesElemSchema returns the schema of each entry in databases: the
+// google_sql_database attributes, plus the etag and kind the API returns.
+func sqlDatabasesElemSchema() map[string]*schema.Schema {
+	elemSchema := tpgresource.DatasourceSchemaFromResourceSchema(ResourceSQLDatabase().Schema)
+	elemSchema["etag"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: `The etag of the database, which changes whenever the database is modified.`,
+	}
+	elemSchema["kind"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: `The kind of the resource, always sql#database.`,
+	}
+	elemSchema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: `The region of the instance the database belongs to.`,
+	}
+	return elemSchema
+}
+
+func dataSourceSqlDatabasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	start := time.Now()
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	project, err := tpgresource.GetProject(d, config)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if v, ok := d.GetOk("charset_family"); ok {
+		filters = append(filters, sqlCharsetFamilyFilter(v.(string)))
+	}
+	fields := expandSqlDatabaseFields(d)
+	timeout := d.Timeout(schema.TimeoutRead)
+	retryOnRateLimit := d.Get("retry_on_rate_limit").(bool)
+	instance := d.Get("instance").(string)
+	if instance != "" {
+		// instance may be a self_link, such as that of a
+		// google_sql_database_instance, whose project is then used.
+		fv, err := tpgresource.ParseProjectFieldValue("instances", instance, "project", d, config, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		project, instance = fv.Project, fv.Name
+	}
+	instances := []string{instance}
+	var listed map[string]*sqladmin.DatabaseInstance
+	connectionName := ""
+	instanceCreateTime := ""
+	instanceRegion, instanceTier := "", ""
+	publicIpAddress, privateIpAddress := "", ""
+	requireSsl := false
+	if v, ok := d.GetOk("instance_regex"); ok {
+		instances, listed, err = listSqlInstancesMatching(ctx, d, config, userAgent, project, v.(string), timeout, retryOnRateLimit)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if sqlDatabasesNeedInstance(d, filters, fields) {
+		inst, err := getSqlInstance(ctx, config, userAgent, project, instances[0], timeout, retryOnRateLimit)
+		if err != nil {
+			if d.Get("validate_instance").(bool) && isSqlInstanceNotFoundError(err) {
+				return diag.Errorf("instance %q not found in project %q", instances[0], project)
+			}
+			return diag.FromErr(transport_tpg.HandleDataSourceNotFoundError(err, d, fmt.Sprintf("Instance %q", instances[0]), fmt.Sprintf("Instance %q", instances[0])))
+		}
+		listed = map[string]*sqladmin.DatabaseInstance{inst.Name: inst}
+		connectionName = sqlInstanceConnectionName(project, inst.Region, inst.Name)
+		instanceCreateTime = inst.CreateTime
+		instanceRegion = inst.Region
+		if inst.Settings != nil {
+			instanceTier = inst.Settings.Tier
+		}
+		publicIpAddress, privateIpAddress = sqlInstanceIpAddresses(inst)
+		requireSsl = sqlInstanceRequiresSsl(inst)
+	}
+	regions := make(map[string]string, len(listed))
+	for name, inst := range listed {
+		regions[name] = inst.Region
+	}
+
+	serverFilter := sqlDatabasesServerFilter(filters, sqlDatabasesListSupportsFilter)
+	requireRunnable := d.Get("require_runnable").(bool)
+	items, failures, err := listSqlDatabasesAcrossInstances(ctx, instances, d.Get("max_concurrency").(int), d.Get("continue_on_error").(bool), func(ctx context.Context, instance string) ([]*sqladmin.Database, error) {
+		if requireRunnable {
+			err := waitForSqlInstanceRunnable(ctx, instance, func() (string, error) {
+				inst, err := getSqlInstance(ctx, config, userAgent, project, instance, timeout, retryOnRateLimit)
+				if err != nil {
+					return "", err
+				}
+				return inst.State, nil
+			}, timeout, sqlInstanceRunnablePollInterval)
+			if err != nil {
+				return nil, transport_tpg.HandleDataSourceNotFoundError(err, d, fmt.Sprintf("Instance %q", instance), fmt.Sprintf("Instance %q", instance))
+			}
+		}
+		databases, err := listSqlDatabases(ctx, config, userAgent, project, instance, serverFilter, timeout, retryOnRateLimit)
+		if err != nil {
+			return nil, transport_tpg.HandleDataSourceNotFoundError(err, d, fmt.Sprintf("Databases in %q instance", instance), fmt.Sprintf("Databases in %q instance", instance))
+		}
+		return databases, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] Cloud SQL API returned %d databases across %d instances in %s", len(items), len(instances), time.Since(start))
+	if !d.Get("include_system_databases").(bool) {
+		items = excludeSqlSystemDatabases(items, listed)
+	}
+
+	//client-side sorting to provide consistent ordering of the databases
+	sortSqlDatabases(items, d.Get("order_by").(string))
+
+	var flattenedDatabases []map[string]interface{}
+	filtersApplied := false
+	if name, ok := d.GetOk("database"); ok {
+		database, err := selectDatabase(d, items, name.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		flattenedDatabases = []map[string]interface{}{flattenDatabase(database, regions, nil)}
+	} else {
+		flattenedDatabases = applyFilterOnDatabases(items, regions, filters, nil)
+		filtersApplied = len(filters) > 0
+		if v, ok := d.GetOk("dedupe_by"); ok {
+			dedupeBy := v.(string)
+			if _, ok := fields[dedupeBy]; fields != nil && !ok {
+				return diag.Errorf("dedupe_by %q requires %q to be listed in fields", dedupeBy, dedupeBy)
+			}
+			flattenedDatabases = dedupeSqlDatabases(flattenedDatabases, dedupeBy)
+		}
+		if limit := d.Get("limit").(int); limit > 0 && len(flattenedDatabases) > limit {
+			flattenedDatabases = flattenedDatabases[:limit]
+		}
+	}
+
+	expectedCharset := d.Get("expected_charset").(string)
+	expectedCollation := d.Get("expected_collation").(string)
+	for _, expected := range []struct{ attribute, value string }{{"charset", expectedCharset}, {"collation", expectedCollation}} {
+		if expected.value == "" || fields == nil {
+			continue
+		}
+		for _, field := range []string{"name", expected.attribute} {
+			if _, ok := fields[field]; !ok {
+				return diag.Errorf("expected_%s requires %q to be listed in fields", expected.attribute, field)
+			}
+		}
+	}
+
+	// fields only applies to the exported databases, so that databases_map and
+	// nonconforming_databases can still key each database by name and instance.
+	databases := projectSqlDatabases(flattenedDatabases, fields)
+	if err := d.Set("databases", databases); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting databases: %s", err))
+	}
+	databasesJSON, err := flattenDatabasesJSON(databases)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("databases_json", databasesJSON); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting databases_json: %s", err))
+	}
+	if err := d.Set("databases_count", len(databases)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting databases_count: %s", err))
+	}
+	if err := d.Set("names", flattenDatabasesAttribute(databases, "name")); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting names: %s", err))
+	}
+	if err := d.Set("self_links", flattenDatabasesAttribute(databases, "self_link")); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting self_links: %s", err))
+	}
+	databasesMap, err := flattenDatabasesMap(databases, sqlDatabaseKeys(flattenedDatabases))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("databases_map", databasesMap); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting databases_map: %s", err))
+	}
+	if err := d.Set("nonconforming_databases", nonconformingSqlDatabases(flattenedDatabases, expectedCharset, expectedCollation)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting nonconforming_databases: %s", err))
+	}
+	if err := d.Set("filters_applied", filtersApplied); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting filters_applied: %s", err))
+	}
+	requestURL := ""
+	if d.Get("debug").(bool) && len(instances) > 0 {
+		// The base path is trimmed to the API root as NewSqlAdminClient does.
+		basePath := transport_tpg.RemoveBasePathVersion(transport_tpg.RemoveBasePathVersion(config.SQLBasePath))
+		requestURL = sqlDatabasesListURL(basePath, project, instances[0], serverFilter)
+	}
+	if err := d.Set("request_url", requestURL); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting request_url: %s", err))
+	}
+	if err := d.Set("errors", flattenSqlInstanceListErrors(failures)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting errors: %s", err))
+	}
+	if err := d.Set("connection_name", connectionName); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting connection_name: %s", err))
+	}
+	if err := d.Set("instance_create_time", instanceCreateTime); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_create_time: %s", err))
+	}
+	if err := d.Set("instance_region", instanceRegion); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_region: %s", err))
+	}
+	if err := d.Set("instance_tier", instanceTier); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting instance_tier: %s", err))
+	}
+	if err := d.Set("public_ip_address", publicIpAddress); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting public_ip_address: %s", err))
+	}
+	if err := d.Set("private_ip_address", privateIpAddress); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting private_ip_address: %s", err))
+	}
+	if err := d.Set("require_ssl", requireSsl); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting require_ssl: %s", err))
+	}
+	id := fmt.Sprintf("project/%s/instance/%s/databases", project, instance)
+	if v, ok := d.GetOk("instance_regex"); ok {
+		id = fmt.Sprintf("project/%s/instance_regex/%s/databases", project, v.(string))
+	}
+	if name, ok := d.GetOk("database"); ok {
+		id += "/" + name.(string)
+	}
+	d.SetId(id)
+	log.Printf("[DEBUG] Read %d of %d databases for %s in %s", len(flattenedDatabases), len(items), id, time.Since(start))
+
+	diags := tpgresource.DatasourceFilterWarnings(filters)
+	if d.Get("warn_on_empty").(bool) {
+		diags = append(diags, warnOnEmptyDatabases(items, flattenedDatabases, filters)...)
+	}
+	return diags
+}
+
+// sqlDatabasesRetryOptions returns the options for retrying a list call made by
+// the data source. Rate limit and quota errors are always retried, backing off
+// exponentially until timeout; retryOnRateLimit additionally waits for the
+// delay advised by the Retry-After header of a 429. Nothing is retried once
+// ctx is done.
+func sqlDatabasesRetryOptions(ctx context.Context, retryFunc func() error, timeout time.Duration, retryOnRateLimit bool) transport_tpg.RetryOptions {
+	opts := transport_tpg.RetryOptions{
+		RetryFunc: retryFunc,
+		Timeout:   timeout,
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{
+			transport_tpg.IsSqlOperationInProgressError,
+			isSqlRateLimitError,
+		},
+		ErrorAbortPredicates: []transport_tpg.RetryErrorPredicateFunc{isSqlContextDone(ctx)},
+	}
+	if retryOnRateLimit {
+		opts.RetryFunc = honorSqlRetryAfter(retryFunc, time.Now().Add(timeout), sleepContext(ctx))
+	}
+	return opts
+}
+
+// isSqlContextDone aborts retrying once ctx is done, as it is when Terraform
+// cancels the read or the read timeout expires. Every later call would fail
+// the same way, and a deadline exceeded error would otherwise be retried as a
+// network timeout.
+func isSqlContextDone(ctx context.Context) transport_tpg.RetryErrorPredicateFunc {
+	return func(err error) (bool, string) {
+		if ctx.Err() != nil {
+			return true, "The read was cancelled or timed out"
+		}
+		return false, ""
+	}
+}
+
+// listSqlDatabases lists the databases of instance. A non-empty serverFilter,
+// as returned by sqlDatabasesServerFilter, is sent as the filter query
+// parameter.
+func listSqlDatabases(ctx context.Context, config *transport_tpg.Config, userAgent, project, instance, serverFilter string, timeout time.Duration, retryOnRateLimit bool) ([]*sqladmin.Database, error) {
+	var opts []googleapi.CallOption
+	if serverFilter != "" {
+		opts = append(opts, googleapi.QueryParameter("filter", serverFilter))
 	}
 	var databases *sqladmin.DatabasesListResponse
-	err = transport_tpg.Retry(transport_tpg.RetryOptions{
-		RetryFunc: func() (rerr error) {
-			databases, rerr = config.NewSqlAdminClient(userAgent).Databases.List(project, d.Get("instance").(string)).Do()
-			return rerr
+	err := transport_tpg.Retry(sqlDatabasesRetryOptions(ctx, func() (rerr error) {
+		databases, rerr = config.NewSqlAdminClient(userAgent).Databases.List(project, instance).Context(ctx).Do(opts...)
+		return rerr
+	}, timeout, retryOnRateLimit))
+	if err != nil {
+		return nil, err
+	}
+	return sqlDatabasesListItems(databases), nil
+}
+
+// sqlDatabasesListURL returns the URL of the Databases.List request for
+// instance, built the way the Cloud SQL Admin client builds it from basePath.
+func sqlDatabasesListURL(basePath, project, instance, serverFilter string) string {
+	params := url.Values{}
+	params.Set("alt", "json")
+	params.Set("prettyPrint", "false")
+	if serverFilter != "" {
+		params.Set("filter", serverFilter)
+	}
+	path := fmt.Sprintf("sql/v1beta4/projects/%s/instances/%s/databases", url.PathEscape(project), url.PathEscape(instance))
+	return googleapi.ResolveRelative(basePath, path) + "?" + params.Encode()
+}
+
+// sqlDatabasesListSupportsFilter reports whether Databases.List accepts a
+// filter query parameter. It does not yet, so every filter is evaluated
+// client-side only.
+var sqlDatabasesListSupportsFilter = false
+
+// sqlDatabasesServerFilter translates the filters blocks that match exact
+// database names into a Databases.List filter expression, such as
+// (name = "a" OR name = "b"). It returns "" when supported is false or no
+// block can be translated. Every filter is still evaluated client-side, so the
+// server only narrows down the databases returned and blocks using regular
+// expressions, other attributes or other options are simply left out.
+func sqlDatabasesServerFilter(filters []*tpgresource.DatasourceFilter, supported bool) string {
+	if !supported {
+		return ""
+	}
+	clauses := make([]string, 0)
+	for _, filter := range filters {
+		if filter.Name != "name" || len(filter.LiteralValues) == 0 || len(filter.Values) > 0 || len(filter.ExcludeValues) > 0 ||
+			filter.IgnoreCase || filter.SegmentDelimiter != "" || filter.Negate {
+			continue
+		}
+		names := make([]string, 0, len(filter.LiteralValues))
+		for _, name := range filter.LiteralValues {
+			names = append(names, fmt.Sprintf("name = %q", name))
+		}
+		clauses = append(clauses, "("+strings.Join(names, " OR ")+")")
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+// sqlDatabasesListItems returns the databases of a list response. Instances
+// without databases omit items, so this is an empty list rather than nil.
+func sqlDatabasesListItems(res *sqladmin.DatabasesListResponse) []*sqladmin.Database {
+	databases := make([]*sqladmin.Database, 0)
+	if res == nil {
+		return databases
+	}
+	for _, database := range res.Items {
+		if database != nil {
+			databases = append(databases, database)
+		}
+	}
+	return databases
+}
+
+// sqlInstanceConnectionName returns the project:region:instance connection
+// name the Cloud SQL Auth Proxy and connectors use to address an instance.
+func sqlInstanceConnectionName(project, region, instance string) string {
+	return fmt.Sprintf("%s:%s:%s", project, region, instance)
+}
+
+// sqlInstanceIpAddresses returns the first public and private IP addresses of
+// inst, as google_sql_database_instance exports them.
+func sqlInstanceIpAddresses(inst *sqladmin.DatabaseInstance) (publicIpAddress, privateIpAddress string) {
+	for _, ip := range inst.IpAddresses {
+		if publicIpAddress == "" && ip.Type == "PRIMARY" {
+			publicIpAddress = ip.IpAddress
+		}
+		if privateIpAddress == "" && ip.Type == "PRIVATE" {
+			privateIpAddress = ip.IpAddress
+		}
+	}
+	return publicIpAddress, privateIpAddress
+}
+
+// sqlInstanceRequiresSsl reports whether inst rejects unencrypted connections.
+// ssl_mode supersedes the deprecated requireSsl flag, which is still honored
+// for instances configured before ssl_mode existed.
+func sqlInstanceRequiresSsl(inst *sqladmin.DatabaseInstance) bool {
+	if inst.Settings == nil || inst.Settings.IpConfiguration == nil {
+		return false
+	}
+	ipConfiguration := inst.Settings.IpConfiguration
+	switch ipConfiguration.SslMode {
+	case "ENCRYPTED_ONLY", "TRUSTED_CLIENT_CERTIFICATE_REQUIRED":
+		return true
+	case "ALLOW_UNENCRYPTED_AND_ENCRYPTED":
+		return false
+	}
+	return ipConfiguration.RequireSsl
+}
+
+// getSqlInstance gets instance, retrying the way list calls are retried.
+func getSqlInstance(ctx context.Context, config *transport_tpg.Config, userAgent, project, instance string, timeout time.Duration, retryOnRateLimit bool) (*sqladmin.DatabaseInstance, error) {
+	var inst *sqladmin.DatabaseInstance
+	err := transport_tpg.Retry(sqlDatabasesRetryOptions(ctx, func() (rerr error) {
+		inst, rerr = config.NewSqlAdminClient(userAgent).Instances.Get(project, instance).Context(ctx).Do()
+		return rerr
+	}, timeout, retryOnRateLimit))
+	return inst, err
+}
+
+// sqlDatabasesNeedInstance reports whether reading the databases of a single
+// instance requires getting the instance itself: to populate region, to
+// filter on it, or for an option that inspects the instance. Otherwise the
+// instance attributes, such as connection_name, are left empty and the read
+// only lists the databases.
+func sqlDatabasesNeedInstance(d *schema.ResourceData, filters []*tpgresource.DatasourceFilter, fields map[string]struct{}) bool {
+	if d.Get("validate_instance").(bool) || d.Get("require_runnable").(bool) || !d.Get("include_system_databases").(bool) {
+		return true
+	}
+	if _, ok := fields["region"]; ok || fields == nil {
+		return true
+	}
+	for _, filter := range filters {
+		if filter.Name == "region" {
+			return true
+		}
+	}
+	return false
+}
+
+// isSqlInstanceNotFoundError reports whether err is how the Cloud SQL Admin API
+// answers a Get of an instance that does not exist. A 403 is left to surface
+// as is, as it may hide a missing permission rather than a missing instance.
+func isSqlInstanceNotFoundError(err error) bool {
+	return transport_tpg.IsGoogleApiErrorWithCode(err, 404)
+}
+
+// sqlInstanceNotRunnableError is returned while waiting for an instance that
+// is not RUNNABLE yet, such as one that is still PENDING_CREATE.
+type sqlInstanceNotRunnableError struct {
+	instance string
+	state    string
+}
+
+func (e *sqlInstanceNotRunnableError) Error() string {
+	return fmt.Sprintf("instance %q is %s, not RUNNABLE", e.instance, e.state)
+}
+
+func isSqlInstanceNotRunnableError(err error) (bool, string) {
+	if e, ok := err.(*sqlInstanceNotRunnableError); ok {
+		return true, fmt.Sprintf("Waiting for instance %q to be RUNNABLE, currently %s", e.instance, e.state)
+	}
+	return false, ""
+}
+
+// waitForSqlInstanceRunnable polls getState every pollInterval until it
+// returns RUNNABLE, giving up once timeout is reached or ctx is done.
+func waitForSqlInstanceRunnable(ctx context.Context, instance string, getState func() (string, error), timeout, pollInterval time.Duration) error {
+	state := ""
+	err := transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() error {
+			s, err := getState()
+			if err != nil {
+				return err
+			}
+			state = s
+			if state != "RUNNABLE" {
+				return &sqlInstanceNotRunnableError{instance: instance, state: state}
+			}
+			return nil
 		},
-		Timeout:              d.Timeout(schema.TimeoutRead),
-		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsSqlOperationInProgressError},
+		Timeout:              timeout,
+		PollInterval:         pollInterval,
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{isSqlInstanceNotRunnableError},
+		ErrorAbortPredicates: []transport_tpg.RetryErrorPredicateFunc{isSqlContextDone(ctx)},
 	})
+	if err != nil && state != "" && state != "RUNNABLE" {
+		return fmt.Errorf("Error waiting for instance %q to be RUNNABLE, last state %s: %w", instance, state, err)
+	}
+	return err
+}
+
+// sqlInstanceListError records an instance whose databases could not be
+// listed when continue_on_error is set.
+type sqlInstanceListError struct {
+	Instance string
+	Err      error
+}
+
+// listSqlDatabasesAcrossInstances calls list for every instance, running at
+// most maxConcurrency calls at a time. The first error cancels the context
+// passed to the calls still running and is returned, unless continueOnError
+// is set, in which case the errors are returned alongside the databases of the
+// other instances. Databases and errors are returned in the order of
+// instances, regardless of the order the calls complete in.
+func listSqlDatabasesAcrossInstances(ctx context.Context, instances []string, maxConcurrency int, continueOnError bool, list func(ctx context.Context, instance string) ([]*sqladmin.Database, error)) ([]*sqladmin.Database, []*sqlInstanceListError, error) {
+	results := make([][]*sqladmin.Database, len(instances))
+	errs := make([]error, len(instances))
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrency)
+	for i, instance := range instances {
+		i, instance := i, instance
+		g.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			databases, err := list(ctx, instance)
+			if err != nil {
+				// A cancelled context fails every remaining call, so it is
+				// returned rather than recorded against each instance.
+				if continueOnError && ctx.Err() == nil {
+					errs[i] = err
+					return nil
+				}
+				return err
+			}
+			results[i] = databases
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	var databases []*sqladmin.Database
+	var failures []*sqlInstanceListError
+	for i, result := range results {
+		databases = append(databases, result...)
+		if errs[i] != nil {
+			failures = append(failures, &sqlInstanceListError{Instance: instances[i], Err: errs[i]})
+		}
+	}
+	return databases, failures, nil
+}
 
+func flattenSqlInstanceListErrors(failures []*sqlInstanceListError) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(failures))
+	for _, failure := range failures {
+		flattened = append(flattened, map[string]interface{}{
+			"instance": failure.Instance,
+			"message":  failure.Err.Error(),
+		})
+	}
+	return flattened
+}
+
+// listSqlInstancesMatching lists the instances in project and returns the
+// names of those matching instanceRegex, bounded by max_instances, along with
+// every listed instance keyed by name.
+func listSqlInstancesMatching(ctx context.Context, d *schema.ResourceData, config *transport_tpg.Config, userAgent, project, instanceRegex string, timeout time.Duration, retryOnRateLimit bool) ([]string, map[string]*sqladmin.DatabaseInstance, error) {
+	re, err := regexp.Compile(instanceRegex)
 	if err != nil {
-		return transport_tpg.HandleDataSourceNotFoundError(err, d, fmt.Sprintf("Databases in %q instance", d.Get("instance").(string)), fmt.Sprintf("Databases in %q instance", d.Get("instance").(string)))
+		return nil, nil, fmt.Errorf("Error compiling instance_regex %q: %s", instanceRegex, err)
 	}
-	flattenedDatabases := flattenDatabases(databases.Items)
 
-	//client-side sorting to provide consistent ordering of the databases
-	sort.SliceStable(flattenedDatabases, func(i, j int) bool {
-		return strings.Compare(flattenedDatabases[i]["name"].(string), flattenedDatabases[j]["name"].(string)) < 1
+	instances, err := transport_tpg.ListAllPages(func(pageToken string) ([]*sqladmin.DatabaseInstance, string, error) {
+		res, err := config.NewSqlAdminClient(userAgent).Instances.List(project).PageToken(pageToken).Context(ctx).Do()
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Items, res.NextPageToken, nil
+	}, func(retryFunc func() error) transport_tpg.RetryOptions {
+		return sqlDatabasesRetryOptions(ctx, retryFunc, timeout, retryOnRateLimit)
 	})
-	if err := d.Set("databases", flattenedDatabases); err != nil {
-		return fmt.Errorf("Error setting databases: %s", err)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error listing instances in project %q: %s", project, err)
+	}
+
+	var names []string
+	listed := make(map[string]*sqladmin.DatabaseInstance)
+	for _, instance := range instances {
+		names = append(names, instance.Name)
+		listed[instance.Name] = instance
 	}
-	d.SetId(fmt.Sprintf("project/%s/instance/%s/databases", project, d.Get("instance").(string)))
-	return nil
+
+	labelFilters, err := expandSqlInstanceLabelFilters(d)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(labelFilters) > 0 {
+		labelled := make([]string, 0, len(names))
+		for _, name := range names {
+			if matchesSqlInstanceLabelFilters(listed[name], labelFilters) {
+				labelled = append(labelled, name)
+			}
+		}
+		log.Printf("[DEBUG] %d of %d instances in project %q satisfied label_filters", len(labelled), len(names), project)
+		names = labelled
+	}
+
+	matched, err := matchSqlInstanceNames(names, re, d.Get("max_instances").(int))
+	if err != nil {
+		return nil, nil, err
+	}
+	return matched, listed, nil
+}
+
+// sqlInstanceLabelFilter selects instances whose user label key has a value
+// matching any of values.
+type sqlInstanceLabelFilter struct {
+	key    string
+	values []*regexp.Regexp
 }
 
-func flattenDatabases(fetchedDatabases []*sqladmin.Database) []map[string]interface{} {
-	if fetchedDatabases == nil {
-		return make([]map[string]interface{}, 0)
+// expandSqlInstanceLabelFilters compiles the label_filters blocks.
+func expandSqlInstanceLabelFilters(d *schema.ResourceData) ([]sqlInstanceLabelFilter, error) {
+	raw := d.Get("label_filters").([]interface{})
+	filters := make([]sqlInstanceLabelFilter, 0, len(raw))
+	for _, v := range raw {
+		block, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		filter := sqlInstanceLabelFilter{key: block["key"].(string)}
+		for _, value := range block["values"].([]interface{}) {
+			pattern, _ := value.(string)
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("Error compiling label_filters %q value %q: %s", filter.key, pattern, err)
+			}
+			filter.values = append(filter.values, re)
+		}
+		filters = append(filters, filter)
 	}
+	return filters, nil
+}
 
-	databases := make([]map[string]interface{}, 0, len(fetchedDatabases))
-	for _, rawDatabase := range fetchedDatabases {
-		database := make(map[string]interface{})
-		database["name"] = rawDatabase.Name
-		database["instance"] = rawDatabase.Instance
-		database["project"] = rawDatabase.Project
-		database["charset"] = rawDatabase.Charset
-		database["collation"] = rawDatabase.Collation
-		database["self_link"] = rawDatabase.SelfLink
+// matchesSqlInstanceLabelFilters reports whether instance satisfies every
+// filter. Instances missing a filtered label never satisfy it.
+func matchesSqlInstanceLabelFilters(instance *sqladmin.DatabaseInstance, filters []sqlInstanceLabelFilter) bool {
+	var labels map[string]string
+	if instance != nil && instance.Settings != nil {
+		labels = instance.Settings.UserLabels
+	}
+	for _, filter := range filters {
+		value, ok := labels[filter.key]
+		if !ok {
+			return false
+		}
+		matched := false
+		for _, re := range filter.values {
+			if re.MatchString(value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
 
-		databases = append(databases, database)
+// excludeSqlSystemDatabases drops the system databases of the engine of each
+// database's instance, looked up in instances by name. Databases of instances
+// that are not in instances are kept.
+func excludeSqlSystemDatabases(databases []*sqladmin.Database, instances map[string]*sqladmin.DatabaseInstance) []*sqladmin.Database {
+	kept := make([]*sqladmin.Database, 0, len(databases))
+	for _, database := range databases {
+		if inst, ok := instances[database.Instance]; ok && isSqlSystemDatabase(inst.DatabaseVersion, database.Name) {
+			continue
+		}
+		kept = append(kept, database)
 	}
-	return databases
+	return kept
+}
+
+// isSqlSystemDatabase reports whether name is a system database of the engine
+// of databaseVersion, such as MYSQL_8_0 or POSTGRES_14.
+func isSqlSystemDatabase(databaseVersion, name string) bool {
+	for prefix, systemDatabases := range sqlSystemDatabases {
+		if !strings.HasPrefix(databaseVersion, prefix) {
+			continue
+		}
+		for _, systemDatabase := range systemDatabases {
+			if name == systemDatabase {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchSqlInstanceNames returns the sorted names matching re, and errors rather
+// than fanning out to more than maxInstances instances.
+func matchSqlInstanceNames(names []string, re *regexp.Regexp, maxInstances int) ([]string, error) {
+	matched := make([]string, 0)
+	for _, name := range names {
+		if re.MatchString(name) {
+			matched = append(matched, name)
+		}
+	}
+	if len(matched) > maxInstances {
+		return nil, fmt.Errorf("instance_regex %q matches %d instances, more than max_instances (%d)", re.String(), len(matched), maxInstances)
+	}
+	sort.Strings(matched)
+	return matched, nil
+}
+
+// warnOnEmptyDatabases returns a warning summarizing filters when they dropped
+// every listed database, as filters that can never match, such as a latin1
+// charset filter on PostgreSQL, are otherwise hard to tell from an empty
+// instance.
+func warnOnEmptyDatabases(listed []*sqladmin.Database, flattened []map[string]interface{}, filters []*tpgresource.DatasourceFilter) diag.Diagnostics {
+	if len(listed) == 0 || len(flattened) > 0 || len(filters) == 0 {
+		return nil
+	}
+	summaries := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		summaries = append(summaries, filter.String())
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "No databases matched the filters",
+			Detail:   fmt.Sprintf("%d databases were listed, but none matched all of the filters: %s", len(listed), strings.Join(summaries, "; ")),
+		},
+	}
+}
+
+// selectDatabase finds the database named name and exports its attributes at
+// the top level of the data source.
+func selectDatabase(d *schema.ResourceData, databases []*sqladmin.Database, name string) (*sqladmin.Database, error) {
+	for _, database := range databases {
+		if database.Name != name {
+			continue
+		}
+		if err := d.Set("charset", database.Charset); err != nil {
+			return nil, fmt.Errorf("Error setting charset: %s", err)
+		}
+		if err := d.Set("collation", database.Collation); err != nil {
+			return nil, fmt.Errorf("Error setting collation: %s", err)
+		}
+		if err := d.Set("self_link", database.SelfLink); err != nil {
+			return nil, fmt.Errorf("Error setting self_link: %s", err)
+		}
+		return database, nil
+	}
+	return nil, fmt.Errorf("Database %q in %q instance not found", name, d.Get("instance").(string))
+}
+
+// applyFilterOnDatabases returns the flattened databases that satisfy filters.
+// Databases are filtered and flattened in a single pass, so instances with many
+// databases never hold a flattened copy of the databases a filter drops.
+func applyFilterOnDatabases(databases []*sqladmin.Database, regions map[string]string, filters []*tpgresource.DatasourceFilter, fields map[string]struct{}) []map[string]interface{} {
+	flattenedDatabases := make([]map[string]interface{}, 0, len(databases))
+	// survived counts, for each filters block, the databases that satisfied it
+	// and every block before it. Blocks are evaluated in order and evaluation
+	// stops at the first block a database does not satisfy.
+	survived := make([]int, len(filters))
+	for _, database := range databases {
+		get := func(name string) string {
+			return databaseFilterField(database, regions, name)
+		}
+		matched := true
+		for i, filter := range filters {
+			if !tpgresource.RegexMatch([]*tpgresource.DatasourceFilter{filter}, get) {
+				matched = false
+				break
+			}
+			survived[i]++
+		}
+		if !matched {
+			continue
+		}
+		flattenedDatabases = append(flattenedDatabases, flattenDatabase(database, regions, fields))
+	}
+	for i, filter := range filters {
+		log.Printf("[DEBUG] %d of %d databases survived filters block %d (%s)", survived[i], len(databases), i, filter)
+	}
+	return flattenedDatabases
+}
+
+// sqlDatabasesComparators compare two databases on each attribute order_by
+// accepts. Databases that compare equal are then ordered by name and instance,
+// so ordering by a new attribute only needs a comparator here.
+var sqlDatabasesComparators = map[string]func(a, b *sqladmin.Database) int{
+	"name": func(a, b *sqladmin.Database) int {
+		return strings.Compare(a.Name, b.Name)
+	},
+	"charset": func(a, b *sqladmin.Database) int {
+		return strings.Compare(a.Charset, b.Charset)
+	},
+	"collation": func(a, b *sqladmin.Database) int {
+		return strings.Compare(a.Collation, b.Collation)
+	},
+}
+
+// sqlDatabasesOrderByKeys returns the attributes order_by accepts, sorted.
+func sqlDatabasesOrderByKeys() []string {
+	keys := make([]string, 0, len(sqlDatabasesComparators))
+	for key := range sqlDatabasesComparators {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// sortSqlDatabases orders databases by the comparator of orderBy, then by name
+// and instance.
+func sortSqlDatabases(databases []*sqladmin.Database, orderBy string) {
+	compare := sqlDatabasesComparators[orderBy]
+	sort.SliceStable(databases, func(i, j int) bool {
+		a, b := databases[i], databases[j]
+		if c := compare(a, b); c != 0 {
+			return c < 0
+		}
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c < 0
+		}
+		return strings.Compare(a.Instance, b.Instance) < 0
+	})
+}
+
+// dedupeSqlDatabases returns the flattened databases, keeping only the first
+// database for each value of the attribute key.
+func dedupeSqlDatabases(databases []map[string]interface{}, key string) []map[string]interface{} {
+	deduped := make([]map[string]interface{}, 0, len(databases))
+	seen := make(map[interface{}]struct{}, len(databases))
+	for _, database := range databases {
+		if _, ok := seen[database[key]]; ok {
+			continue
+		}
+		seen[database[key]] = struct{}{}
+		deduped = append(deduped, database)
+	}
+	return deduped
+}
+
+// sqlCharsetFamilyFilter returns the charset filter charset_family expands to.
+func sqlCharsetFamilyFilter(family string) *tpgresource.DatasourceFilter {
+	return &tpgresource.DatasourceFilter{
+		Name:   "charset",
+		Values: []*regexp.Regexp{regexp.MustCompile(sqlCharsetFamilies[family])},
+	}
+}
+
+// databaseFilterField returns the value of the database attribute a filter
+// targets. The region is that of the instance, looked up in regions, and the
+// collation family is the collation up to its first underscore, such as
+// utf8mb4 for utf8mb4_0900_ai_ci.
+func databaseFilterField(database *sqladmin.Database, regions map[string]string, name string) string {
+	switch name {
+	case "name":
+		return database.Name
+	case "charset":
+		return database.Charset
+	case "collation":
+		return database.Collation
+	case "collation_family":
+		return strings.SplitN(database.Collation, "_", 2)[0]
+	case "instance":
+		return database.Instance
+	case "region":
+		return regions[database.Instance]
+	}
+	return ""
+}
+
+// sqlDatabaseKeys returns the key of each flattened database: its name, which
+// is unique within an instance but not across them, so names that appear on
+// several instances are keyed by instance/name instead.
+func sqlDatabaseKeys(databases []map[string]interface{}) []string {
+	instances := make(map[string]map[string]struct{})
+	for _, database := range databases {
+		name := database["name"].(string)
+		if instances[name] == nil {
+			instances[name] = make(map[string]struct{})
+		}
+		instances[name][database["instance"].(string)] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(databases))
+	for _, database := range databases {
+		key := database["name"].(string)
+		if len(instances[key]) > 1 {
+			key = fmt.Sprintf("%s/%s", database["instance"].(string), key)
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// flattenDatabasesMap keys the JSON encoding of each flattened database by the
+// matching entry of keys, as returned by sqlDatabaseKeys.
+func flattenDatabasesMap(databases []map[string]interface{}, keys []string) (map[string]interface{}, error) {
+	databasesMap := make(map[string]interface{}, len(databases))
+	for i, database := range databases {
+		encoded, err := json.Marshal(database)
+		if err != nil {
+			return nil, fmt.Errorf("Error encoding database %q: %s", keys[i], err)
+		}
+		databasesMap[keys[i]] = string(encoded)
+	}
+	return databasesMap, nil
+}
+
+// flattenDatabasesAttribute returns the attribute of each flattened database.
+// It is "" for the databases where the attribute is not in fields, so that the
+// values stay aligned with databases.
+func flattenDatabasesAttribute(databases []map[string]interface{}, attribute string) []string {
+	values := make([]string, 0, len(databases))
+	for _, database := range databases {
+		v, _ := database[attribute].(string)
+		values = append(values, v)
+	}
+	return values
+}
+
+// nonconformingSqlDatabases returns the keys, as returned by sqlDatabaseKeys,
+// of the flattened databases whose charset differs from expectedCharset or
+// whose collation differs from expectedCollation. An empty expected value
+// matches any database.
+func nonconformingSqlDatabases(databases []map[string]interface{}, expectedCharset, expectedCollation string) []string {
+	keys := sqlDatabaseKeys(databases)
+	nonconforming := make([]string, 0)
+	for i, database := range databases {
+		if (expectedCharset == "" || database["charset"] == expectedCharset) && (expectedCollation == "" || database["collation"] == expectedCollation) {
+			continue
+		}
+		nonconforming = append(nonconforming, keys[i])
+	}
+	return nonconforming
+}
+
+// flattenDatabasesJSON encodes the sqlDatabasesJSONFields of each flattened
+// database as a JSON array. Object keys are encoded in sorted order, so the
+// encoding only changes when the databases do.
+func flattenDatabasesJSON(databases []map[string]interface{}) (string, error) {
+	encoded := make([]map[string]interface{}, 0, len(databases))
+	for _, database := range databases {
+		fields := make(map[string]interface{}, len(sqlDatabasesJSONFields))
+		for _, field := range sqlDatabasesJSONFields {
+			fields[field] = database[field]
+		}
+		encoded = append(encoded, fields)
+	}
+	raw, err := json.Marshal(encoded)
+	if err != nil {
+		return "", fmt.Errorf("Error encoding databases: %s", err)
+	}
+	return string(raw), nil
+}
+
+// expandSqlDatabaseFields returns the attributes selected by fields, or nil
+// when every attribute should be populated.
+func expandSqlDatabaseFields(d *schema.ResourceData) map[string]struct{} {
+	v, ok := d.GetOk("fields")
+	if !ok {
+		return nil
+	}
+	fields := make(map[string]struct{})
+	for _, field := range v.([]interface{}) {
+		fields[field.(string)] = struct{}{}
+	}
+	return fields
+}
+
+// flattenDatabase flattens rawDatabase, looking up the region of its instance
+// in regions and leaving the attributes missing from a non-nil fields empty.
+func flattenDatabase(rawDatabase *sqladmin.Database, regions map[string]string, fields map[string]struct{}) map[string]interface{} {
+	database := make(map[string]interface{})
+	database["name"] = rawDatabase.Name
+	database["instance"] = rawDatabase.Instance
+	database["project"] = rawDatabase.Project
+	database["charset"] = rawDatabase.Charset
+	database["collation"] = rawDatabase.Collation
+	database["self_link"] = rawDatabase.SelfLink
+	database["etag"] = rawDatabase.Etag
+	database["kind"] = rawDatabase.Kind
+	database["region"] = regions[rawDatabase.Instance]
+
+	return projectSqlDatabase(database, fields)
+}
+
+// projectSqlDatabases returns a copy of each flattened database with the
+// attributes missing from a non-nil fields left empty.
+func projectSqlDatabases(databases []map[string]interface{}, fields map[string]struct{}) []map[string]interface{} {
+	projected := make([]map[string]interface{}, 0, len(databases))
+	for _, database := range databases {
+		projected = append(projected, projectSqlDatabase(database, fields))
+	}
+	return projected
+}
+
+// projectSqlDatabase returns a copy of the flattened database with the
+// attributes missing from a non-nil fields left empty.
+func projectSqlDatabase(database map[string]interface{}, fields map[string]struct{}) map[string]interface{} {
+	projected := make(map[string]interface{}, len(database))
+	for k, v := range database {
+		projected[k] = v
+	}
+	if fields != nil {
+		for _, field := range sqlDatabaseFields {
+			if _, ok := fields[field]; !ok {
+				projected[field] = ""
+			}
+		}
+	}
+	return projected
 }
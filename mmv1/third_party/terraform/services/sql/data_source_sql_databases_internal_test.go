@@ -0,0 +1,1643 @@
+package sql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/googleapi"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+)
+
+func testDatabasesFilters(t *testing.T, filters []interface{}) []*tpgresource.DatasourceFilter {
+	d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+		"instance": "instance",
+		"filters":  filters,
+	})
+	expanded, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		t.Fatalf("unexpected error expanding filters: %s", err)
+	}
+	return expanded
+}
+
+func testDatabases(count int) []*sqladmin.Database {
+	databases := make([]*sqladmin.Database, 0, count)
+	for i := 0; i < count; i++ {
+		charset := "UTF8"
+		if i%2 == 0 {
+			charset = "LATIN1"
+		}
+		databases = append(databases, &sqladmin.Database{
+			Name:      fmt.Sprintf("db-%d", i),
+			Instance:  "instance",
+			Project:   "project",
+			Charset:   charset,
+			Collation: "en_US.UTF8",
+			SelfLink:  fmt.Sprintf("https://sqladmin.googleapis.com/sql/v1beta4/projects/project/instances/instance/databases/db-%d", i),
+			Etag:      fmt.Sprintf("etag-%d", i),
+			Kind:      "sql#database",
+		})
+	}
+	return databases
+}
+
+// flattenThenFilterDatabases flattens every database before filtering the
+// flattened list, and is kept as the reference for applyFilterOnDatabases.
+func flattenThenFilterDatabases(databases []*sqladmin.Database, filters []*tpgresource.DatasourceFilter) []map[string]interface{} {
+	flattenedDatabases := make([]map[string]interface{}, 0, len(databases))
+	for _, database := range databases {
+		flattenedDatabases = append(flattenedDatabases, flattenDatabase(database, nil, nil))
+	}
+	return tpgresource.ApplyDatasourceFilters(filters, flattenedDatabases)
+}
+
+func TestApplyFilterOnDatabases(t *testing.T) {
+	databases := testDatabases(10)
+
+	cases := map[string]struct {
+		Filters       []interface{}
+		ExpectedCount int
+	}{
+		"no filters": {
+			ExpectedCount: 10,
+		},
+		"charset filter": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "charset", "values": []interface{}{"^UTF8$"}},
+			},
+			ExpectedCount: 5,
+		},
+		"name and charset filters": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "charset", "values": []interface{}{"^UTF8$"}},
+				map[string]interface{}{"name": "name", "exclude_values": []interface{}{"^db-1$"}},
+			},
+			ExpectedCount: 4,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			filters := testDatabasesFilters(t, tc.Filters)
+
+			got := applyFilterOnDatabases(databases, nil, filters, nil)
+			if len(got) != tc.ExpectedCount {
+				t.Errorf("expected %d databases, got %d", tc.ExpectedCount, len(got))
+			}
+			if want := flattenThenFilterDatabases(databases, filters); !reflect.DeepEqual(got, want) {
+				t.Errorf("expected output identical to flattening before filtering, got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestApplyFilterOnDatabases_nameOrCollation(t *testing.T) {
+	databases := testDatabases(4)
+	databases[3].Collation = "C"
+
+	filters := testDatabasesFilters(t, []interface{}{
+		map[string]interface{}{"name": "name,collation", "values": []interface{}{"^db-0$", "^C$"}},
+	})
+
+	got := applyFilterOnDatabases(databases, nil, filters, nil)
+	if len(got) != 2 || got[0]["name"] != "db-0" || got[1]["name"] != "db-3" {
+		t.Errorf("expected db-0 to match by name and db-3 by collation, got %v", got)
+	}
+}
+
+func TestApplyFilterOnDatabases_emptyCollation(t *testing.T) {
+	databases := testDatabases(4)
+	databases[1].Collation = ""
+	databases[2].Collation = ""
+
+	filters := testDatabasesFilters(t, []interface{}{
+		map[string]interface{}{"name": "collation", "match_empty": true},
+	})
+
+	got := applyFilterOnDatabases(databases, nil, filters, nil)
+	if len(got) != 2 || got[0]["name"] != "db-1" || got[1]["name"] != "db-2" {
+		t.Errorf("expected only db-1 and db-2, which have an empty collation, got %v", got)
+	}
+}
+
+func TestApplyFilterOnDatabases_debugLog(t *testing.T) {
+	t.Setenv("TF_LOG", "DEBUG")
+	var buf bytes.Buffer
+	defer log.SetOutput(log.Writer())
+	log.SetOutput(&buf)
+
+	filters := testDatabasesFilters(t, []interface{}{
+		map[string]interface{}{"name": "charset", "values": []interface{}{"^UTF8$"}},
+		map[string]interface{}{"name": "name", "exclude_values": []interface{}{"^db-1$"}},
+	})
+
+	got := applyFilterOnDatabases(testDatabases(10), nil, filters, nil)
+	if len(got) != 4 {
+		t.Errorf("expected 4 databases, got %d", len(got))
+	}
+	for _, want := range []string{
+		"[DEBUG] 5 of 10 databases survived filters block 0 (charset values=[^UTF8$])",
+		"[DEBUG] 4 of 10 databases survived filters block 1 (name exclude_values=[^db-1$])",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("expected the log to contain %q, got %q", want, buf.String())
+		}
+	}
+}
+
+func TestApplyFilterOnDatabases_fields(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+		"instance": "instance",
+		"fields":   []interface{}{"name"},
+	})
+
+	got := applyFilterOnDatabases(testDatabases(2), nil, nil, expandSqlDatabaseFields(d))
+	if len(got) != 2 {
+		t.Fatalf("expected 2 databases, got %d", len(got))
+	}
+	for _, database := range got {
+		if database["name"] == "" {
+			t.Errorf("expected name to be populated, got %v", database)
+		}
+		for _, field := range []string{"charset", "collation", "self_link", "project", "instance", "etag", "kind", "region"} {
+			if database[field] != "" {
+				t.Errorf("expected %s to be empty, got %q", field, database[field])
+			}
+		}
+	}
+}
+
+func TestApplyFilterOnDatabases_noDatabases(t *testing.T) {
+	filters := []*tpgresource.DatasourceFilter{
+		{
+			Name:   "name",
+			Values: []*regexp.Regexp{regexp.MustCompile("^app-")},
+		},
+	}
+
+	// An instance without databases lists nil, and the data source must still
+	// set an empty, non-nil list of databases whether or not filters are set.
+	for _, f := range [][]*tpgresource.DatasourceFilter{nil, filters} {
+		for _, databases := range [][]*sqladmin.Database{nil, {}} {
+			got := applyFilterOnDatabases(databases, nil, f, nil)
+			if got == nil || len(got) != 0 {
+				t.Errorf("expected an empty non-nil list of databases, got %#v", got)
+			}
+		}
+	}
+}
+
+func TestSqlDatabasesListItems(t *testing.T) {
+	cases := map[string]*sqladmin.DatabasesListResponse{
+		"nil response": nil,
+		"nil items":    {},
+		"nil entry":    {Items: []*sqladmin.Database{nil}},
+	}
+	for tn, res := range cases {
+		got := sqlDatabasesListItems(res)
+		if got == nil || len(got) != 0 {
+			t.Errorf("%s: expected an empty non-nil list of databases, got %#v", tn, got)
+		}
+	}
+
+	res := &sqladmin.DatabasesListResponse{Items: []*sqladmin.Database{{Name: "app-db"}, nil}}
+	if got := sqlDatabasesListItems(res); len(got) != 1 || got[0].Name != "app-db" {
+		t.Errorf("expected only app-db, got %#v", got)
+	}
+}
+
+func TestApplyFilterOnDatabases_regions(t *testing.T) {
+	databases := testDatabases(2)
+	databases[1].Instance = "instance-east"
+	regions := map[string]string{
+		"instance":      "us-central1",
+		"instance-east": "us-east1",
+	}
+
+	got := applyFilterOnDatabases(databases, regions, nil, nil)
+	for i, expected := range []string{"us-central1", "us-east1"} {
+		if got[i]["region"] != expected {
+			t.Errorf("expected database %d to be in %s, got %v", i, expected, got[i]["region"])
+		}
+	}
+}
+
+func TestApplyFilterOnDatabases_filterByRegion(t *testing.T) {
+	databases := testDatabases(4)
+	databases[1].Instance = "instance-east"
+	databases[3].Instance = "instance-east"
+	regions := map[string]string{
+		"instance":      "us-central1",
+		"instance-east": "us-east1",
+	}
+
+	filters := testDatabasesFilters(t, []interface{}{
+		map[string]interface{}{"name": "region", "values": []interface{}{"^us-east"}},
+	})
+	got := applyFilterOnDatabases(databases, regions, filters, nil)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 databases in us-east1, got %d", len(got))
+	}
+	for _, database := range got {
+		if database["instance"] != "instance-east" {
+			t.Errorf("expected only databases of instance-east, got %v", database["instance"])
+		}
+	}
+
+	filters = testDatabasesFilters(t, []interface{}{
+		map[string]interface{}{"name": "instance", "literal_values": []interface{}{"instance"}},
+	})
+	if got := applyFilterOnDatabases(databases, regions, filters, nil); len(got) != 2 || got[0]["region"] != "us-central1" {
+		t.Errorf("expected the 2 databases of instance in us-central1, got %v", got)
+	}
+}
+
+func TestExcludeSqlSystemDatabases(t *testing.T) {
+	instances := map[string]*sqladmin.DatabaseInstance{
+		"mysql":    {Name: "mysql", DatabaseVersion: "MYSQL_8_0"},
+		"postgres": {Name: "postgres", DatabaseVersion: "POSTGRES_14"},
+	}
+	databases := []*sqladmin.Database{
+		{Name: "sys", Instance: "mysql"},
+		{Name: "information_schema", Instance: "mysql"},
+		{Name: "app", Instance: "mysql"},
+		{Name: "postgres", Instance: "postgres"},
+		{Name: "template1", Instance: "postgres"},
+		// sys is only a system database on MySQL.
+		{Name: "sys", Instance: "postgres"},
+		{Name: "app", Instance: "postgres"},
+		{Name: "mysql", Instance: "unknown"},
+	}
+
+	var got []string
+	for _, database := range excludeSqlSystemDatabases(databases, instances) {
+		got = append(got, database.Instance+"/"+database.Name)
+	}
+	if want := []string{"mysql/app", "postgres/sys", "postgres/app", "unknown/mysql"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestApplyFilterOnDatabases_collationFamily(t *testing.T) {
+	databases := []*sqladmin.Database{
+		{Name: "db-bin", Collation: "utf8mb4_bin"},
+		{Name: "db-ai-ci", Collation: "utf8mb4_0900_ai_ci"},
+		{Name: "db-utf8mb3", Collation: "utf8mb3_general_ci"},
+		{Name: "db-latin1", Collation: "latin1_swedish_ci"},
+		{Name: "db-none"},
+	}
+
+	filters := testDatabasesFilters(t, []interface{}{
+		map[string]interface{}{"name": "collation_family", "values": []interface{}{"^utf8mb4$"}},
+	})
+	var got []string
+	for _, database := range applyFilterOnDatabases(databases, nil, filters, nil) {
+		got = append(got, database["name"].(string))
+	}
+	if want := []string{"db-bin", "db-ai-ci"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected the utf8mb4 collation family to match %v, got %v", want, got)
+	}
+
+	// The collation itself is unchanged, so the suffix can still be matched on.
+	filters = testDatabasesFilters(t, []interface{}{
+		map[string]interface{}{"name": "collation", "values": []interface{}{"^utf8mb4$"}},
+	})
+	if got := applyFilterOnDatabases(databases, nil, filters, nil); len(got) != 0 {
+		t.Errorf("expected no collation to equal utf8mb4, got %v", got)
+	}
+}
+
+func TestSqlCharsetFamilyFilter_utf8(t *testing.T) {
+	var databases []*sqladmin.Database
+	for _, charset := range []string{"UTF8", "utf8mb3", "utf8mb4", "LATIN1", "utf16", "utf8mb5"} {
+		databases = append(databases, &sqladmin.Database{Name: "db-" + charset, Charset: charset})
+	}
+
+	var got []string
+	for _, database := range applyFilterOnDatabases(databases, nil, []*tpgresource.DatasourceFilter{sqlCharsetFamilyFilter("utf8")}, nil) {
+		got = append(got, database["charset"].(string))
+	}
+	if want := []string{"UTF8", "utf8mb3", "utf8mb4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected utf8 family to match %v, got %v", want, got)
+	}
+}
+
+func TestSqlCharsetFamilyFilter_withCharsetFilter(t *testing.T) {
+	databases := []*sqladmin.Database{
+		{Name: "db-utf8", Charset: "utf8"},
+		{Name: "db-utf8mb4", Charset: "utf8mb4"},
+		{Name: "db-latin1", Charset: "latin1"},
+	}
+
+	// An explicit charset filter still applies alongside the family.
+	filters := append(testDatabasesFilters(t, []interface{}{
+		map[string]interface{}{"name": "charset", "exclude_values": []interface{}{"mb4$"}},
+	}), sqlCharsetFamilyFilter("utf8"))
+
+	got := applyFilterOnDatabases(databases, nil, filters, nil)
+	if len(got) != 1 || got[0]["name"] != "db-utf8" {
+		t.Errorf("expected only db-utf8, got %v", got)
+	}
+}
+
+func TestSortSqlDatabases(t *testing.T) {
+	cases := map[string]struct {
+		orderBy string
+		want    []string
+	}{
+		"name":      {orderBy: "name", want: []string{"a/app", "b/app", "a/billing", "a/reports"}},
+		"charset":   {orderBy: "charset", want: []string{"a/reports", "a/app", "b/app", "a/billing"}},
+		"collation": {orderBy: "collation", want: []string{"a/billing", "a/app", "b/app", "a/reports"}},
+	}
+	for tn, tc := range cases {
+		databases := []*sqladmin.Database{
+			{Name: "reports", Instance: "a", Charset: "LATIN1", Collation: "latin1_swedish_ci"},
+			{Name: "app", Instance: "b", Charset: "UTF8", Collation: "en_US.UTF8"},
+			{Name: "billing", Instance: "a", Charset: "UTF8", Collation: "C"},
+			{Name: "app", Instance: "a", Charset: "UTF8", Collation: "en_US.UTF8"},
+		}
+		sortSqlDatabases(databases, tc.orderBy)
+
+		got := make([]string, 0, len(databases))
+		for _, database := range databases {
+			got = append(got, database.Instance+"/"+database.Name)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.want, got)
+		}
+	}
+}
+
+func TestSqlDatabasesOrderByKeys(t *testing.T) {
+	if got, want := sqlDatabasesOrderByKeys(), []string{"charset", "collation", "name"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestWarnOnEmptyDatabases(t *testing.T) {
+	databases := testDatabases(4)
+	filters := testDatabasesFilters(t, []interface{}{
+		map[string]interface{}{"name": "charset", "values": []interface{}{"^SQL_ASCII$"}},
+	})
+
+	flattened := applyFilterOnDatabases(databases, nil, filters, nil)
+	if len(flattened) != 0 {
+		t.Fatalf("expected the charset filter to match no databases, got %d", len(flattened))
+	}
+
+	diags := warnOnEmptyDatabases(databases, flattened, filters)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail, "charset values=[^SQL_ASCII$]") {
+		t.Errorf("expected the warning to summarize the filters, got %q", diags[0].Detail)
+	}
+
+	if diags := warnOnEmptyDatabases(nil, nil, filters); len(diags) != 0 {
+		t.Errorf("expected no warning for an instance without databases, got %v", diags)
+	}
+}
+
+func TestFlattenDatabasesMap(t *testing.T) {
+	databases := []*sqladmin.Database{
+		{Name: "pg-db1", Instance: "instance-a", Charset: "UTF8"},
+		{Name: "pg-db2", Instance: "instance-a", Charset: "UTF8"},
+		{Name: "pg-db2", Instance: "instance-b", Charset: "LATIN1"},
+	}
+
+	flattened := applyFilterOnDatabases(databases, nil, nil, nil)
+	got, err := flattenDatabasesMap(flattened, sqlDatabaseKeys(flattened))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	keys := make([]string, 0, len(got))
+	for key := range got {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if want := []string{"instance-a/pg-db2", "instance-b/pg-db2", "pg-db1"}; !reflect.DeepEqual(keys, want) {
+		t.Fatalf("expected keys %v, got %v", want, keys)
+	}
+
+	var database map[string]interface{}
+	if err := json.Unmarshal([]byte(got["pg-db1"].(string)), &database); err != nil {
+		t.Fatalf("unexpected error decoding pg-db1: %s", err)
+	}
+	if database["charset"] != "UTF8" || database["instance"] != "instance-a" {
+		t.Errorf("expected pg-db1 to decode to its database, got %v", database)
+	}
+
+	// Databases are still keyed by name and instance when fields leaves them
+	// out of the encoded databases.
+	projected := projectSqlDatabases(flattened, map[string]struct{}{"charset": {}})
+	got, err = flattenDatabasesMap(projected, sqlDatabaseKeys(flattened))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(got) != len(databases) {
+		t.Fatalf("expected %d entries, got %v", len(databases), got)
+	}
+	if err := json.Unmarshal([]byte(got["instance-b/pg-db2"].(string)), &database); err != nil {
+		t.Fatalf("unexpected error decoding instance-b/pg-db2: %s", err)
+	}
+	if database["charset"] != "LATIN1" || database["name"] != "" {
+		t.Errorf("expected instance-b/pg-db2 to decode to its projected database, got %v", database)
+	}
+}
+
+func TestFlattenDatabasesJSON(t *testing.T) {
+	databases := testDatabases(2)
+	flattened := applyFilterOnDatabases(databases, nil, nil, nil)
+
+	got, err := flattenDatabasesJSON(flattened)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var decoded []map[string]interface{}
+	if err := json.Unmarshal([]byte(got), &decoded); err != nil {
+		t.Fatalf("unexpected error decoding %q: %s", got, err)
+	}
+	var names []string
+	for _, database := range decoded {
+		names = append(names, database["name"].(string))
+		if len(database) != len(sqlDatabasesJSONFields) {
+			t.Errorf("expected only %v to be encoded, got %v", sqlDatabasesJSONFields, database)
+		}
+	}
+	if want := []string{"db-0", "db-1"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("expected names %v, got %v", want, names)
+	}
+
+	// Keys are sorted, so encoding the same databases again is identical.
+	if !strings.HasPrefix(got, `[{"charset":"LATIN1","collation":"en_US.UTF8","instance":"instance","name":"db-0",`) {
+		t.Errorf("expected keys to be encoded in sorted order, got %s", got)
+	}
+	if again, _ := flattenDatabasesJSON(flattened); again != got {
+		t.Errorf("expected a deterministic encoding, got %s and %s", got, again)
+	}
+
+	if empty, _ := flattenDatabasesJSON(nil); empty != "[]" {
+		t.Errorf("expected an empty array for no databases, got %s", empty)
+	}
+}
+
+func TestMatchSqlInstanceNames(t *testing.T) {
+	names := []string{"prod-b", "dev-a", "prod-a"}
+	re := regexp.MustCompile("^prod-")
+
+	got, err := matchSqlInstanceNames(names, re, 50)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := []string{"prod-a", "prod-b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected matching instances %v, got %v", want, got)
+	}
+
+	if _, err := matchSqlInstanceNames(names, re, 1); err == nil {
+		t.Errorf("expected an error when more instances than max_instances match")
+	}
+}
+
+func TestMatchesSqlInstanceLabelFilters(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+		"instance_regex": ".*",
+		"label_filters": []interface{}{
+			map[string]interface{}{"key": "environment", "values": []interface{}{"^prod$", "^staging$"}},
+			map[string]interface{}{"key": "team", "values": []interface{}{"^billing$"}},
+		},
+	})
+	filters, err := expandSqlInstanceLabelFilters(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cases := map[string]struct {
+		Instance *sqladmin.DatabaseInstance
+		Expected bool
+	}{
+		"every label matches": {
+			Instance: &sqladmin.DatabaseInstance{Settings: &sqladmin.Settings{UserLabels: map[string]string{"environment": "staging", "team": "billing"}}},
+			Expected: true,
+		},
+		"one label does not match": {
+			Instance: &sqladmin.DatabaseInstance{Settings: &sqladmin.Settings{UserLabels: map[string]string{"environment": "dev", "team": "billing"}}},
+			Expected: false,
+		},
+		"label missing": {
+			Instance: &sqladmin.DatabaseInstance{Settings: &sqladmin.Settings{UserLabels: map[string]string{"environment": "prod"}}},
+			Expected: false,
+		},
+		"no settings": {
+			Instance: &sqladmin.DatabaseInstance{},
+			Expected: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := matchesSqlInstanceLabelFilters(tc.Instance, filters); got != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestListSqlDatabasesAcrossInstances(t *testing.T) {
+	instances := []string{"instance-a", "instance-b", "instance-c", "instance-d"}
+
+	var mu sync.Mutex
+	queried := make(map[string]bool)
+	list := func(ctx context.Context, instance string) ([]*sqladmin.Database, error) {
+		mu.Lock()
+		queried[instance] = true
+		mu.Unlock()
+		return []*sqladmin.Database{
+			{Name: "db", Instance: instance},
+		}, nil
+	}
+
+	got, failures, err := listSqlDatabasesAcrossInstances(context.Background(), instances, 2, false, list)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(failures) != 0 {
+		t.Errorf("expected no failures, got %v", failures)
+	}
+	if len(queried) != len(instances) {
+		t.Errorf("expected all %d instances to be queried, got %v", len(instances), queried)
+	}
+	if len(got) != len(instances) {
+		t.Fatalf("expected %d databases, got %d", len(instances), len(got))
+	}
+	for i, database := range got {
+		if database.Instance != instances[i] {
+			t.Errorf("expected database %d to belong to %q, got %q", i, instances[i], database.Instance)
+		}
+	}
+}
+
+func TestListSqlDatabasesAcrossInstances_concurrencyLimit(t *testing.T) {
+	instances := []string{"instance-a", "instance-b", "instance-c", "instance-d", "instance-e", "instance-f"}
+	maxConcurrency := 2
+
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+	list := func(ctx context.Context, instance string) ([]*sqladmin.Database, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+
+		// Earlier instances take longer, so calls complete out of order.
+		for i, name := range instances {
+			if name == instance {
+				time.Sleep(time.Duration(len(instances)-i) * 5 * time.Millisecond)
+			}
+		}
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return []*sqladmin.Database{
+			{Name: "db", Instance: instance},
+		}, nil
+	}
+
+	got, _, err := listSqlDatabasesAcrossInstances(context.Background(), instances, maxConcurrency, false, list)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if peak > maxConcurrency {
+		t.Errorf("expected at most %d concurrent calls, got %d", maxConcurrency, peak)
+	}
+	if len(got) != len(instances) {
+		t.Fatalf("expected %d databases, got %d", len(instances), len(got))
+	}
+	for i, database := range got {
+		if database.Instance != instances[i] {
+			t.Errorf("expected database %d to belong to %q regardless of completion order, got %q", i, instances[i], database.Instance)
+		}
+	}
+}
+
+func TestListSqlDatabasesAcrossInstances_error(t *testing.T) {
+	instances := []string{"instance-a", "instance-b", "instance-c"}
+	listErr := errors.New("boom")
+
+	list := func(ctx context.Context, instance string) ([]*sqladmin.Database, error) {
+		if instance == "instance-a" {
+			return nil, listErr
+		}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	// With every instance listed concurrently, the remaining calls only
+	// return once the failing call cancels them.
+	if _, _, err := listSqlDatabasesAcrossInstances(context.Background(), instances, len(instances), false, list); err != listErr {
+		t.Errorf("expected error %q, got %v", listErr, err)
+	}
+}
+
+func TestListSqlDatabasesAcrossInstances_continueOnError(t *testing.T) {
+	instances := []string{"instance-a", "instance-b"}
+	forbidden := &googleapi.Error{Code: 403, Message: "The client is not authorized to make this request."}
+
+	list := func(ctx context.Context, instance string) ([]*sqladmin.Database, error) {
+		if instance == "instance-a" {
+			return nil, forbidden
+		}
+		return []*sqladmin.Database{
+			{Name: "db", Instance: instance},
+		}, nil
+	}
+
+	got, failures, err := listSqlDatabasesAcrossInstances(context.Background(), instances, len(instances), true, list)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(got) != 1 || got[0].Instance != "instance-b" {
+		t.Errorf("expected the databases of instance-b, got %v", got)
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"instance": "instance-a",
+			"message":  forbidden.Error(),
+		},
+	}
+	if flattened := flattenSqlInstanceListErrors(failures); !reflect.DeepEqual(flattened, expected) {
+		t.Errorf("expected errors %v, got %v", expected, flattened)
+	}
+}
+
+func benchmarkDatabasesFilters() []*tpgresource.DatasourceFilter {
+	return []*tpgresource.DatasourceFilter{
+		{
+			Name:   "charset",
+			Values: []*regexp.Regexp{regexp.MustCompile("^UTF8$")},
+		},
+	}
+}
+
+func BenchmarkApplyFilterOnDatabases(b *testing.B) {
+	databases := testDatabases(5000)
+	filters := benchmarkDatabasesFilters()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		applyFilterOnDatabases(databases, nil, filters, nil)
+	}
+}
+
+func BenchmarkFlattenThenFilterDatabases(b *testing.B) {
+	databases := testDatabases(5000)
+	filters := benchmarkDatabasesFilters()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		flattenThenFilterDatabases(databases, filters)
+	}
+}
+
+func TestWaitForSqlInstanceRunnable(t *testing.T) {
+	states := []string{"PENDING_CREATE", "PENDING_CREATE", "RUNNABLE"}
+	calls := 0
+	getState := func() (string, error) {
+		state := states[calls]
+		calls++
+		return state, nil
+	}
+
+	if err := waitForSqlInstanceRunnable(context.Background(), "inst-1", getState, time.Minute, time.Millisecond); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != len(states) {
+		t.Errorf("expected the state to be fetched %d times, got %d", len(states), calls)
+	}
+}
+
+func TestWaitForSqlInstanceRunnable_timeout(t *testing.T) {
+	getState := func() (string, error) {
+		return "PENDING_CREATE", nil
+	}
+
+	err := waitForSqlInstanceRunnable(context.Background(), "inst-1", getState, 50*time.Millisecond, time.Millisecond)
+	if err == nil {
+		t.Fatal("expected an error for an instance that never becomes RUNNABLE")
+	}
+	if !strings.Contains(err.Error(), "last state PENDING_CREATE") {
+		t.Errorf("expected the error to report the last state, got %q", err)
+	}
+}
+
+func TestSqlInstanceConnectionName(t *testing.T) {
+	got := sqlInstanceConnectionName("my-project", "us-central1", "tf-test-instance-abc")
+	if want := "my-project:us-central1:tf-test-instance-abc"; got != want {
+		t.Errorf("expected connection name %q, got %q", want, got)
+	}
+}
+
+func TestDataSourceSqlDatabases_readTimeout(t *testing.T) {
+	r := DataSourceSqlDatabases()
+
+	cases := map[string]struct {
+		config map[string]interface{}
+		want   time.Duration
+	}{
+		"default": {
+			config: map[string]interface{}{"instance": "instance"},
+			want:   20 * time.Minute,
+		},
+		"override": {
+			config: map[string]interface{}{
+				"instance": "instance",
+				"timeouts": map[string]interface{}{"read": "10m"},
+			},
+			want: 10 * time.Minute,
+		},
+	}
+	for tn, tc := range cases {
+		timeouts := &schema.ResourceTimeout{}
+		if err := timeouts.ConfigDecode(r, terraform.NewResourceConfigRaw(tc.config)); err != nil {
+			t.Fatalf("%s: unexpected error decoding timeouts: %s", tn, err)
+		}
+		if timeouts.Read == nil || *timeouts.Read != tc.want {
+			t.Errorf("%s: expected a read timeout of %s, got %v", tn, tc.want, timeouts.Read)
+		}
+	}
+}
+
+func TestSqlDatabasesNeedInstance(t *testing.T) {
+	cases := map[string]struct {
+		raw  map[string]interface{}
+		want bool
+	}{
+		"default fields": {
+			raw:  map[string]interface{}{"instance": "main"},
+			want: true,
+		},
+		"fields without region": {
+			raw:  map[string]interface{}{"instance": "main", "fields": []interface{}{"name", "charset"}},
+			want: false,
+		},
+		"fields with region": {
+			raw:  map[string]interface{}{"instance": "main", "fields": []interface{}{"name", "region"}},
+			want: true,
+		},
+		"region filter": {
+			raw: map[string]interface{}{
+				"instance": "main",
+				"fields":   []interface{}{"name"},
+				"filters": []interface{}{
+					map[string]interface{}{"name": "region", "values": []interface{}{"^us-"}},
+				},
+			},
+			want: true,
+		},
+		"validate_instance": {
+			raw:  map[string]interface{}{"instance": "main", "fields": []interface{}{"name"}, "validate_instance": true},
+			want: true,
+		},
+		"require_runnable": {
+			raw:  map[string]interface{}{"instance": "main", "fields": []interface{}{"name"}, "require_runnable": true},
+			want: true,
+		},
+		"without system databases": {
+			raw:  map[string]interface{}{"instance": "main", "fields": []interface{}{"name"}, "include_system_databases": false},
+			want: true,
+		},
+	}
+	for tn, tc := range cases {
+		d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, tc.raw)
+		filters, err := tpgresource.ExpandDatasourceFilters(d)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		if got := sqlDatabasesNeedInstance(d, filters, expandSqlDatabaseFields(d)); got != tc.want {
+			t.Errorf("%s: expected %t, got %t", tn, tc.want, got)
+		}
+	}
+}
+
+func TestIsSqlInstanceNotFoundError(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"not found": {err: &googleapi.Error{Code: 404}, want: true},
+		"forbidden": {err: &googleapi.Error{Code: 403}, want: false},
+		"internal":  {err: &googleapi.Error{Code: 500}, want: false},
+		"other":     {err: errors.New("connection reset"), want: false},
+	}
+	for tn, tc := range cases {
+		if got := isSqlInstanceNotFoundError(tc.err); got != tc.want {
+			t.Errorf("%s: expected %t, got %t", tn, tc.want, got)
+		}
+	}
+}
+
+func TestSqlDatabasesRetryOptions_rateLimit(t *testing.T) {
+	calls := 0
+	retryFunc := func() error {
+		calls++
+		if calls == 1 {
+			return testSqlRateLimitError("")
+		}
+		return nil
+	}
+
+	// Rate limit errors are retried even without retry_on_rate_limit.
+	if err := transport_tpg.Retry(sqlDatabasesRetryOptions(context.Background(), retryFunc, time.Minute, false)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected the rate limited call to be retried once, got %d calls", calls)
+	}
+}
+
+func TestSqlDatabasesRetryOptions_operationInProgress(t *testing.T) {
+	calls := 0
+	retryFunc := func() error {
+		calls++
+		if calls == 1 {
+			return &googleapi.Error{Code: 409, Body: "operationInProgress"}
+		}
+		return nil
+	}
+
+	if err := transport_tpg.Retry(sqlDatabasesRetryOptions(context.Background(), retryFunc, time.Minute, false)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected the call to be retried once, got %d calls", calls)
+	}
+}
+
+func TestDataSourceSqlDatabases_projectOverride(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/projects/host-project/instances/shared-instance"):
+			fmt.Fprint(w, `{"name": "shared-instance", "project": "host-project", "region": "us-central1", "databaseVersion": "POSTGRES_15"}`)
+		case strings.HasSuffix(r.URL.Path, "/projects/host-project/instances/shared-instance/databases"):
+			fmt.Fprint(w, `{"items": [{"name": "app", "instance": "shared-instance", "project": "host-project"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 404, "message": "not found"}}`)
+		}
+	}))
+	defer ts.Close()
+
+	config := &transport_tpg.Config{
+		Project:     "provider-project",
+		SQLBasePath: ts.URL + "/",
+		Client:      ts.Client(),
+		Context:     context.Background(),
+	}
+	d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+		"project":  "host-project",
+		"instance": "shared-instance",
+	})
+
+	if diags := dataSourceSqlDatabasesRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(paths) != 2 {
+		t.Fatalf("expected the instance and its databases to be read, got requests %v", paths)
+	}
+	for _, path := range paths {
+		if !strings.Contains(path, "/projects/host-project/") {
+			t.Errorf("expected request %q to target the project of the data source", path)
+		}
+	}
+	if got := d.Get("databases.0.project").(string); got != "host-project" {
+		t.Errorf("expected the database of host-project, got %q", got)
+	}
+	if got, want := d.Id(), "project/host-project/instance/shared-instance/databases"; got != want {
+		t.Errorf("expected id %q, got %q", want, got)
+	}
+}
+
+func TestDataSourceSqlDatabases_contextDone(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/databases") {
+			// Hang like an unresponsive API until the client gives up.
+			<-r.Context().Done()
+			return
+		}
+		fmt.Fprint(w, `{"name": "instance", "region": "us-central1", "databaseVersion": "POSTGRES_15"}`)
+	}))
+	defer ts.Close()
+
+	cases := map[string]struct {
+		context func() (context.Context, context.CancelFunc)
+		want    error
+	}{
+		"cancelled": {
+			context: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(50*time.Millisecond, cancel)
+				return ctx, cancel
+			},
+			want: context.Canceled,
+		},
+		"timed out": {
+			context: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 50*time.Millisecond)
+			},
+			want: context.DeadlineExceeded,
+		},
+	}
+	for tn, tc := range cases {
+		config := &transport_tpg.Config{
+			Project:     "project",
+			SQLBasePath: ts.URL + "/",
+			Client:      ts.Client(),
+			Context:     context.Background(),
+		}
+		d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+			"instance": "instance",
+		})
+
+		ctx, cancel := tc.context()
+		start := time.Now()
+		diags := dataSourceSqlDatabasesRead(ctx, d, config)
+		cancel()
+		if !diags.HasError() {
+			t.Fatalf("%s: expected an error", tn)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("%s: expected the read to return promptly, took %s", tn, elapsed)
+		}
+		if !strings.Contains(diags[0].Summary, tc.want.Error()) {
+			t.Errorf("%s: expected a %q error, got %q", tn, tc.want, diags[0].Summary)
+		}
+	}
+}
+
+func TestSqlDatabasesServerFilter(t *testing.T) {
+	cases := map[string]struct {
+		Filters   []interface{}
+		Supported bool
+		Expected  string
+	}{
+		"not supported": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name", "literal_values": []interface{}{"app"}},
+			},
+			Expected: "",
+		},
+		"no filters": {
+			Supported: true,
+			Expected:  "",
+		},
+		"exact names": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name", "literal_values": []interface{}{"app", "billing"}},
+			},
+			Supported: true,
+			Expected:  `(name = "app" OR name = "billing")`,
+		},
+		"several exact name blocks": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name", "literal_values": []interface{}{"app", "billing"}},
+				map[string]interface{}{"name": "name", "literal_values": []interface{}{"app"}},
+			},
+			Supported: true,
+			Expected:  `(name = "app" OR name = "billing") AND (name = "app")`,
+		},
+		"regular expressions are evaluated client-side": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name", "literal_values": []interface{}{"app"}, "values": []interface{}{"^billing-"}},
+				map[string]interface{}{"name": "name", "literal_values": []interface{}{"app"}, "exclude_values": []interface{}{"-test$"}},
+			},
+			Supported: true,
+			Expected:  "",
+		},
+		"other attributes are evaluated client-side": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "charset", "literal_values": []interface{}{"UTF8"}},
+				map[string]interface{}{"name": "name,collation", "literal_values": []interface{}{"app"}},
+			},
+			Supported: true,
+			Expected:  "",
+		},
+		"other options are evaluated client-side": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name", "literal_values": []interface{}{"App"}, "ignore_case": true},
+				map[string]interface{}{"name": "name", "literal_values": []interface{}{"app"}, "negate": true},
+				map[string]interface{}{"name": "name", "literal_values": []interface{}{"app"}, "segment_delimiter": "-"},
+			},
+			Supported: true,
+			Expected:  "",
+		},
+		"only translatable blocks are sent": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "charset", "values": []interface{}{"^UTF8$"}},
+				map[string]interface{}{"name": "name", "literal_values": []interface{}{"app"}},
+			},
+			Supported: true,
+			Expected:  `(name = "app")`,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			filters := testDatabasesFilters(t, tc.Filters)
+			if got := sqlDatabasesServerFilter(filters, tc.Supported); got != tc.Expected {
+				t.Errorf("expected %q, got %q", tc.Expected, got)
+			}
+		})
+	}
+}
+
+// testSqlDatabasesServer serves an instance with the app and billing databases
+// and records the filter query parameter of every list of its databases.
+func testSqlDatabasesServer(t *testing.T, listFilters *[]string) *httptest.Server {
+	var mu sync.Mutex
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/projects/project/instances/instance"):
+			fmt.Fprint(w, `{"name": "instance", "project": "project", "region": "us-central1", "databaseVersion": "POSTGRES_15", "createTime": "2024-03-01T12:34:56.789Z", "settings": {"tier": "db-custom-2-7680"}}`)
+		case strings.HasSuffix(r.URL.Path, "/projects/project/instances/instance/databases"):
+			mu.Lock()
+			*listFilters = append(*listFilters, r.URL.Query().Get("filter"))
+			mu.Unlock()
+			fmt.Fprint(w, `{"items": [{"name": "app", "instance": "instance", "project": "project"}, {"name": "billing", "instance": "instance", "project": "project"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 404, "message": "not found"}}`)
+		}
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestDataSourceSqlDatabases_serverFilterFallback(t *testing.T) {
+	var listFilters []string
+	ts := testSqlDatabasesServer(t, &listFilters)
+
+	config := &transport_tpg.Config{
+		Project:     "project",
+		SQLBasePath: ts.URL + "/",
+		Client:      ts.Client(),
+		Context:     context.Background(),
+	}
+	d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+		"instance": "instance",
+		"filters": []interface{}{
+			map[string]interface{}{"name": "name", "literal_values": []interface{}{"app"}},
+		},
+	})
+
+	if diags := dataSourceSqlDatabasesRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !reflect.DeepEqual(listFilters, []string{""}) {
+		t.Errorf("expected a single list without a filter query parameter, got filters %q", listFilters)
+	}
+	if got := d.Get("databases_count").(int); got != 1 {
+		t.Fatalf("expected the filter to be applied client-side, got %d databases", got)
+	}
+	if got := d.Get("databases.0.name").(string); got != "app" {
+		t.Errorf("expected database app, got %q", got)
+	}
+}
+
+func TestListSqlDatabases_serverFilter(t *testing.T) {
+	var listFilters []string
+	ts := testSqlDatabasesServer(t, &listFilters)
+
+	config := &transport_tpg.Config{
+		SQLBasePath: ts.URL + "/",
+		Client:      ts.Client(),
+		Context:     context.Background(),
+	}
+
+	if _, err := listSqlDatabases(context.Background(), config, "", "project", "instance", `(name = "app")`, time.Minute, false); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(listFilters, []string{`(name = "app")`}) {
+		t.Errorf("expected the server filter to be sent as the filter query parameter, got filters %q", listFilters)
+	}
+}
+
+func TestDataSourceSqlDatabases_requestURL(t *testing.T) {
+	supported := sqlDatabasesListSupportsFilter
+	sqlDatabasesListSupportsFilter = true
+	defer func() { sqlDatabasesListSupportsFilter = supported }()
+
+	var listFilters []string
+	ts := testSqlDatabasesServer(t, &listFilters)
+
+	for _, debug := range []bool{false, true} {
+		config := &transport_tpg.Config{
+			Project:     "project",
+			SQLBasePath: ts.URL + "/",
+			Client:      ts.Client(),
+			Context:     context.Background(),
+		}
+		d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+			"instance": "instance",
+			"debug":    debug,
+			"filters": []interface{}{
+				map[string]interface{}{"name": "name", "literal_values": []interface{}{"app"}},
+			},
+		})
+
+		if diags := dataSourceSqlDatabasesRead(context.Background(), d, config); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		requestURL := d.Get("request_url").(string)
+		if !debug {
+			if requestURL != "" {
+				t.Errorf("expected no request_url without debug, got %q", requestURL)
+			}
+			continue
+		}
+
+		u, err := url.Parse(requestURL)
+		if err != nil {
+			t.Fatalf("unexpected error parsing request_url %q: %s", requestURL, err)
+		}
+		if want := ts.URL + "/sql/v1beta4/projects/project/instances/instance/databases"; u.Scheme+"://"+u.Host+u.Path != want {
+			t.Errorf("expected a request to %q, got %q", want, requestURL)
+		}
+		if got, want := u.Query().Get("filter"), `(name = "app")`; got != want {
+			t.Errorf("expected the filter query parameter %q, got %q", want, got)
+		}
+		if got := u.Query().Get("alt"); got != "json" {
+			t.Errorf("expected the alt query parameter json, got %q", got)
+		}
+	}
+}
+
+func TestDataSourceSqlDatabases_instanceCreateTime(t *testing.T) {
+	var listFilters []string
+	ts := testSqlDatabasesServer(t, &listFilters)
+
+	config := &transport_tpg.Config{
+		Project:     "project",
+		SQLBasePath: ts.URL + "/",
+		Client:      ts.Client(),
+		Context:     context.Background(),
+	}
+	d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+		"instance": "instance",
+	})
+
+	if diags := dataSourceSqlDatabasesRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	got := d.Get("instance_create_time").(string)
+	createTime, err := time.Parse(time.RFC3339, got)
+	if err != nil {
+		t.Fatalf("expected instance_create_time to be an RFC3339 timestamp, got %q: %s", got, err)
+	}
+	if want := time.Date(2024, 3, 1, 12, 34, 56, 789000000, time.UTC); !createTime.Equal(want) {
+		t.Errorf("expected instance_create_time %s, got %s", want, createTime)
+	}
+}
+
+func TestDataSourceSqlDatabases_instanceRegionAndTier(t *testing.T) {
+	var listFilters []string
+	ts := testSqlDatabasesServer(t, &listFilters)
+
+	config := &transport_tpg.Config{
+		Project:     "project",
+		SQLBasePath: ts.URL + "/",
+		Client:      ts.Client(),
+		Context:     context.Background(),
+	}
+	d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+		"instance": "instance",
+	})
+
+	if diags := dataSourceSqlDatabasesRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got := d.Get("instance_region").(string); got != "us-central1" {
+		t.Errorf("expected instance_region us-central1, got %q", got)
+	}
+	if got := d.Get("instance_tier").(string); got != "db-custom-2-7680" {
+		t.Errorf("expected instance_tier db-custom-2-7680, got %q", got)
+	}
+}
+
+func TestDataSourceSqlDatabases_dedupeBy(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/projects/project/instances"):
+			fmt.Fprint(w, `{"items": [{"name": "shard-1", "region": "us-central1", "databaseVersion": "POSTGRES_15"}, {"name": "shard-2", "region": "us-east1", "databaseVersion": "POSTGRES_15"}]}`)
+		case strings.HasSuffix(r.URL.Path, "/projects/project/instances/shard-1/databases"):
+			fmt.Fprint(w, `{"items": [{"name": "app_db", "instance": "shard-1", "project": "project"}, {"name": "audit_db", "instance": "shard-1", "project": "project"}]}`)
+		case strings.HasSuffix(r.URL.Path, "/projects/project/instances/shard-2/databases"):
+			fmt.Fprint(w, `{"items": [{"name": "app_db", "instance": "shard-2", "project": "project"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 404, "message": "not found"}}`)
+		}
+	}))
+	defer ts.Close()
+
+	config := &transport_tpg.Config{
+		Project:     "project",
+		SQLBasePath: ts.URL + "/",
+		Client:      ts.Client(),
+		Context:     context.Background(),
+	}
+
+	cases := map[string]struct {
+		Config   map[string]interface{}
+		Expected []string
+	}{
+		"without dedupe_by": {
+			Config:   map[string]interface{}{"instance_regex": "^shard-"},
+			Expected: []string{"shard-1/app_db", "shard-2/app_db", "shard-1/audit_db"},
+		},
+		"dedupe_by name": {
+			Config:   map[string]interface{}{"instance_regex": "^shard-", "dedupe_by": "name"},
+			Expected: []string{"shard-1/app_db", "shard-1/audit_db"},
+		},
+		"dedupe_by name after filtering": {
+			Config: map[string]interface{}{
+				"instance_regex": "^shard-",
+				"dedupe_by":      "name",
+				"filters": []interface{}{
+					map[string]interface{}{"name": "region", "values": []interface{}{"^us-east1$"}},
+				},
+			},
+			Expected: []string{"shard-2/app_db"},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, tc.Config)
+			if diags := dataSourceSqlDatabasesRead(context.Background(), d, config); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			got := make([]string, 0)
+			for _, database := range d.Get("databases").([]interface{}) {
+				database := database.(map[string]interface{})
+				got = append(got, fmt.Sprintf("%s/%s", database["instance"], database["name"]))
+			}
+			if !reflect.DeepEqual(got, tc.Expected) {
+				t.Errorf("expected databases %v, got %v", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestDataSourceSqlDatabases_namesAndSelfLinks(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/projects/project/instances/instance") {
+			fmt.Fprint(w, `{"name": "instance", "project": "project", "region": "us-central1", "databaseVersion": "POSTGRES_15"}`)
+			return
+		}
+		fmt.Fprint(w, `{"items": [
+			{"name": "orders_db", "instance": "instance", "project": "project", "selfLink": "https://sqladmin.googleapis.com/sql/v1beta4/projects/project/instances/instance/databases/orders_db"},
+			{"name": "app_db", "instance": "instance", "project": "project", "selfLink": "https://sqladmin.googleapis.com/sql/v1beta4/projects/project/instances/instance/databases/app_db"},
+			{"name": "postgres", "instance": "instance", "project": "project", "selfLink": "https://sqladmin.googleapis.com/sql/v1beta4/projects/project/instances/instance/databases/postgres"}
+		]}`)
+	}))
+	defer ts.Close()
+
+	config := &transport_tpg.Config{
+		Project:     "project",
+		SQLBasePath: ts.URL + "/",
+		Client:      ts.Client(),
+		Context:     context.Background(),
+	}
+
+	d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+		"instance": "instance",
+		"filters": []interface{}{
+			map[string]interface{}{"name": "name", "values": []interface{}{"_db$"}},
+		},
+	})
+	if diags := dataSourceSqlDatabasesRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, expected := d.Get("names").([]interface{}), []interface{}{"app_db", "orders_db"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected names %v, got %v", expected, got)
+	}
+	expectedSelfLinks := []interface{}{
+		"https://sqladmin.googleapis.com/sql/v1beta4/projects/project/instances/instance/databases/app_db",
+		"https://sqladmin.googleapis.com/sql/v1beta4/projects/project/instances/instance/databases/orders_db",
+	}
+	if got := d.Get("self_links").([]interface{}); !reflect.DeepEqual(got, expectedSelfLinks) {
+		t.Errorf("expected self_links %v, got %v", expectedSelfLinks, got)
+	}
+
+	// Attributes left out of fields stay empty, keeping the lists aligned
+	// with databases.
+	d = schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+		"instance": "instance",
+		"fields":   []interface{}{"name"},
+		"filters": []interface{}{
+			map[string]interface{}{"name": "name", "values": []interface{}{"_db$"}},
+		},
+	})
+	if diags := dataSourceSqlDatabasesRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got, expected := d.Get("self_links").([]interface{}), []interface{}{"", ""}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected self_links %v, got %v", expected, got)
+	}
+}
+
+func TestDataSourceSqlDatabases_dedupeByRequiresField(t *testing.T) {
+	var listFilters []string
+	ts := testSqlDatabasesServer(t, &listFilters)
+
+	config := &transport_tpg.Config{
+		Project:     "project",
+		SQLBasePath: ts.URL + "/",
+		Client:      ts.Client(),
+		Context:     context.Background(),
+	}
+	d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+		"instance":  "instance",
+		"dedupe_by": "name",
+		"fields":    []interface{}{"charset"},
+	})
+
+	diags := dataSourceSqlDatabasesRead(context.Background(), d, config)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, `dedupe_by "name" requires "name" to be listed in fields`) {
+		t.Errorf("expected dedupe_by to require its attribute in fields, got %v", diags)
+	}
+}
+
+func TestDataSourceSqlDatabases_invalidRegex(t *testing.T) {
+	diags := DataSourceSqlDatabases().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"instance": "instance",
+		"filters": []interface{}{
+			map[string]interface{}{"name": "name", "exclude_values": []interface{}{"^app-", "pg-["}},
+		},
+	}))
+	if len(diags) != 1 {
+		t.Fatalf("expected a single diagnostic, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Summary, "exclude_values (pg-[) is not a valid regex pattern") {
+		t.Errorf("expected the diagnostic to name the invalid pattern, got %q", diags[0].Summary)
+	}
+	want := cty.GetAttrPath("filters").IndexInt(0).GetAttr("exclude_values").IndexInt(1)
+	if !diags[0].AttributePath.Equals(want) {
+		t.Errorf("expected the diagnostic to point at %#v, got %#v", want, diags[0].AttributePath)
+	}
+}
+
+func TestDataSourceSqlDatabases_limit(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/projects/project/instances/instance"):
+			fmt.Fprint(w, `{"name": "instance", "project": "project", "region": "us-central1", "databaseVersion": "POSTGRES_15"}`)
+		case strings.HasSuffix(r.URL.Path, "/projects/project/instances/instance/databases"):
+			fmt.Fprint(w, `{"items": [{"name": "c_db", "instance": "instance", "project": "project"}, {"name": "a_db", "instance": "instance", "project": "project"}, {"name": "postgres", "instance": "instance", "project": "project"}, {"name": "b_db", "instance": "instance", "project": "project"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 404, "message": "not found"}}`)
+		}
+	}))
+	defer ts.Close()
+
+	config := &transport_tpg.Config{
+		Project:     "project",
+		SQLBasePath: ts.URL + "/",
+		Client:      ts.Client(),
+		Context:     context.Background(),
+	}
+	filters := []interface{}{
+		map[string]interface{}{"name": "name", "values": []interface{}{"_db$"}},
+	}
+
+	cases := map[string]struct {
+		Config   map[string]interface{}
+		Expected []string
+	}{
+		"without limit": {
+			Config:   map[string]interface{}{"instance": "instance", "filters": filters},
+			Expected: []string{"a_db", "b_db", "c_db"},
+		},
+		"limit 0": {
+			Config:   map[string]interface{}{"instance": "instance", "filters": filters, "limit": 0},
+			Expected: []string{"a_db", "b_db", "c_db"},
+		},
+		"limit 2": {
+			Config:   map[string]interface{}{"instance": "instance", "filters": filters, "limit": 2},
+			Expected: []string{"a_db", "b_db"},
+		},
+		"limit above the number of matches": {
+			Config:   map[string]interface{}{"instance": "instance", "filters": filters, "limit": 5},
+			Expected: []string{"a_db", "b_db", "c_db"},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, tc.Config)
+			if diags := dataSourceSqlDatabasesRead(context.Background(), d, config); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			got := make([]string, 0)
+			for _, database := range d.Get("databases").([]interface{}) {
+				got = append(got, database.(map[string]interface{})["name"].(string))
+			}
+			if !reflect.DeepEqual(got, tc.Expected) {
+				t.Errorf("expected databases %v, got %v", tc.Expected, got)
+			}
+			if count := d.Get("databases_count").(int); count != len(tc.Expected) {
+				t.Errorf("expected databases_count %d, got %d", len(tc.Expected), count)
+			}
+		})
+	}
+}
+
+func TestNonconformingSqlDatabases(t *testing.T) {
+	databases := applyFilterOnDatabases([]*sqladmin.Database{
+		{Name: "app", Instance: "instance-a", Charset: "UTF8", Collation: "en_US.UTF8"},
+		{Name: "billing", Instance: "instance-a", Charset: "LATIN1", Collation: "en_US.UTF8"},
+		{Name: "reports", Instance: "instance-a", Charset: "UTF8", Collation: "C"},
+		{Name: "shared", Instance: "instance-a", Charset: "UTF8", Collation: "en_US.UTF8"},
+		{Name: "shared", Instance: "instance-b", Charset: "SQL_ASCII", Collation: "en_US.UTF8"},
+	}, nil, nil, nil)
+
+	cases := map[string]struct {
+		Databases         []map[string]interface{}
+		ExpectedCharset   string
+		ExpectedCollation string
+		Expected          []string
+	}{
+		"no expectations": {
+			Expected: []string{},
+		},
+		"expected charset": {
+			ExpectedCharset: "UTF8",
+			Expected:        []string{"billing", "instance-b/shared"},
+		},
+		"expected collation": {
+			ExpectedCollation: "en_US.UTF8",
+			Expected:          []string{"reports"},
+		},
+		"expected charset and collation": {
+			ExpectedCharset:   "UTF8",
+			ExpectedCollation: "en_US.UTF8",
+			Expected:          []string{"billing", "reports", "instance-b/shared"},
+		},
+		"all conforming": {
+			Databases:         databases[:1],
+			ExpectedCharset:   "UTF8",
+			ExpectedCollation: "en_US.UTF8",
+			Expected:          []string{},
+		},
+	}
+
+	for tn, tc := range cases {
+		input := databases
+		if tc.Databases != nil {
+			input = tc.Databases
+		}
+		if got := nonconformingSqlDatabases(input, tc.ExpectedCharset, tc.ExpectedCollation); !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: expected nonconforming databases %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestDataSourceSqlDatabases_nonconformingDatabases(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/projects/project/instances/instance"):
+			fmt.Fprint(w, `{"name": "instance", "project": "project", "region": "us-central1", "databaseVersion": "POSTGRES_15"}`)
+		case strings.HasSuffix(r.URL.Path, "/projects/project/instances/instance/databases"):
+			fmt.Fprint(w, `{"items": [{"name": "app", "instance": "instance", "project": "project", "charset": "UTF8", "collation": "en_US.UTF8"}, {"name": "legacy", "instance": "instance", "project": "project", "charset": "LATIN1", "collation": "en_US.UTF8"}, {"name": "old_app", "instance": "instance", "project": "project", "charset": "LATIN1", "collation": "C"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 404, "message": "not found"}}`)
+		}
+	}))
+	defer ts.Close()
+
+	config := &transport_tpg.Config{
+		Project:     "project",
+		SQLBasePath: ts.URL + "/",
+		Client:      ts.Client(),
+		Context:     context.Background(),
+	}
+	d := schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+		"instance":         "instance",
+		"expected_charset": "UTF8",
+		"filters": []interface{}{
+			map[string]interface{}{"name": "name", "exclude_values": []interface{}{"^old_"}},
+		},
+	})
+
+	if diags := dataSourceSqlDatabasesRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got := d.Get("nonconforming_databases").([]interface{}); !reflect.DeepEqual(got, []interface{}{"legacy"}) {
+		t.Errorf("expected only the filtered nonconforming database legacy, got %v", got)
+	}
+	if got := d.Get("databases_count").(int); got != 2 {
+		t.Errorf("expected databases to be left unchanged with 2 databases, got %d", got)
+	}
+
+	d = schema.TestResourceDataRaw(t, DataSourceSqlDatabases().Schema, map[string]interface{}{
+		"instance":           "instance",
+		"expected_collation": "C",
+		"fields":             []interface{}{"name", "charset"},
+	})
+	diags := dataSourceSqlDatabasesRead(context.Background(), d, config)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, `expected_collation requires "collation" to be listed in fields`) {
+		t.Errorf("expected expected_collation to require collation in fields, got %v", diags)
+	}
+}
+
+func TestSqlInstanceIpAddresses(t *testing.T) {
+	inst := &sqladmin.DatabaseInstance{
+		IpAddresses: []*sqladmin.IpMapping{
+			{Type: "OUTGOING", IpAddress: "203.0.113.1"},
+			{Type: "PRIMARY", IpAddress: "203.0.113.2"},
+			{Type: "PRIVATE", IpAddress: "10.0.0.2"},
+			{Type: "PRIMARY", IpAddress: "203.0.113.3"},
+		},
+	}
+	public, private := sqlInstanceIpAddresses(inst)
+	if public != "203.0.113.2" || private != "10.0.0.2" {
+		t.Errorf("expected the first PRIMARY and PRIVATE addresses, got %q and %q", public, private)
+	}
+
+	if public, private := sqlInstanceIpAddresses(&sqladmin.DatabaseInstance{}); public != "" || private != "" {
+		t.Errorf("expected no addresses, got %q and %q", public, private)
+	}
+}
+
+func TestSqlInstanceRequiresSsl(t *testing.T) {
+	cases := map[string]struct {
+		Settings *sqladmin.Settings
+		Expected bool
+	}{
+		"no settings": {},
+		"no ip configuration": {
+			Settings: &sqladmin.Settings{},
+		},
+		"ssl mode encrypted only": {
+			Settings: &sqladmin.Settings{IpConfiguration: &sqladmin.IpConfiguration{SslMode: "ENCRYPTED_ONLY"}},
+			Expected: true,
+		},
+		"ssl mode trusted client certificate required": {
+			Settings: &sqladmin.Settings{IpConfiguration: &sqladmin.IpConfiguration{SslMode: "TRUSTED_CLIENT_CERTIFICATE_REQUIRED"}},
+			Expected: true,
+		},
+		"ssl mode allows unencrypted": {
+			Settings: &sqladmin.Settings{IpConfiguration: &sqladmin.IpConfiguration{SslMode: "ALLOW_UNENCRYPTED_AND_ENCRYPTED", RequireSsl: true}},
+		},
+		"legacy require ssl": {
+			Settings: &sqladmin.Settings{IpConfiguration: &sqladmin.IpConfiguration{RequireSsl: true}},
+			Expected: true,
+		},
+	}
+	for tn, tc := range cases {
+		if got := sqlInstanceRequiresSsl(&sqladmin.DatabaseInstance{Settings: tc.Settings}); got != tc.Expected {
+			t.Errorf("%s: expected require_ssl %t, got %t", tn, tc.Expected, got)
+		}
+	}
+}
@@ -1,10 +1,14 @@
 package sql_test
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"regexp"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -35,6 +39,277 @@ func TestAccDataSourceSqlDatabases_basic(t *testing.T) {
 							"id":              {},
 						},
 					),
+					resource.TestCheckResourceAttrSet("data.google_sql_databases.qa", "databases.0.etag"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "databases.0.kind", "sql#database"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "databases.0.region", "us-central1"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "databases.1.region", "us-central1"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "databases_count", "3"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "filters_applied", "false"),
+					testAccCheckSqlDatabasesJSONNames("data.google_sql_databases.qa", "pg-db1", "pg-db2", "postgres"),
+					resource.TestMatchResourceAttr("data.google_sql_databases.qa", "connection_name", regexp.MustCompile(fmt.Sprintf(`^[^:]+:us-central1:tf-test-instance-%s$`, context["random_suffix"]))),
+					resource.TestCheckResourceAttrWith("data.google_sql_databases.qa", "instance_create_time", func(value string) error {
+						_, err := time.Parse(time.RFC3339, value)
+						return err
+					}),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "instance_region", "us-central1"),
+					resource.TestCheckResourceAttrPair("data.google_sql_databases.qa", "instance_tier", "google_sql_database_instance.main", "settings.0.tier"),
+					resource.TestCheckResourceAttrWith("data.google_sql_databases.qa", "public_ip_address", func(value string) error {
+						if net.ParseIP(value) == nil {
+							return fmt.Errorf("expected public_ip_address to be a valid IP address, got %q", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrPair("data.google_sql_databases.qa", "public_ip_address", "google_sql_database_instance.main", "public_ip_address"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "private_ip_address", ""),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "require_ssl", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSqlDatabases_filters(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSqlDatabases_filters(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "databases.#", "1"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "databases_map.%", "1"),
+					resource.TestCheckResourceAttrSet("data.google_sql_databases.qa", "databases_map.pg-db1"),
+					resource.TestCheckOutput("pg_db1_charset", "UTF8"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "databases.0.name", "pg-db1"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "databases.0.charset", "UTF8"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "filters_applied", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSqlDatabases_database(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSqlDatabases_database(context, "pg-db1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "databases.#", "1"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "databases.0.name", "pg-db1"),
+					resource.TestCheckResourceAttrPair("data.google_sql_databases.qa", "charset", "google_sql_database.db1", "charset"),
+					resource.TestCheckResourceAttrPair("data.google_sql_databases.qa", "collation", "google_sql_database.db1", "collation"),
+					resource.TestCheckResourceAttrPair("data.google_sql_databases.qa", "self_link", "google_sql_database.db1", "self_link"),
+				),
+			},
+			{
+				Config:      testAccDataSourceSqlDatabases_database(context, "pg-missing"),
+				ExpectError: regexp.MustCompile(`Database "pg-missing" in "tf-test-instance-.*" instance not found`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSqlDatabases_validateInstance(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceSqlDatabases_validateInstance(context),
+				ExpectError: regexp.MustCompile(`instance "tf-test-missing-.*" not found in project ".+"`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSqlDatabases_invalidRegex(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceSqlDatabases_invalidRegex(context),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`values \(pg-\[\) is not a valid regex pattern`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSqlDatabases_instanceRegex(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSqlDatabases_instanceRegex(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_sql_databases.prod", "databases.#", "2"),
+					resource.TestCheckResourceAttrPair("data.google_sql_databases.prod", "databases.0.instance", "google_sql_database_instance.prod1", "name"),
+					resource.TestCheckResourceAttrPair("data.google_sql_databases.prod", "databases.1.instance", "google_sql_database_instance.prod2", "name"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.prod", "databases.0.region", "us-central1"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.prod", "databases.1.region", "us-central1"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.prod", "connection_name", ""),
+					resource.TestCheckResourceAttr("data.google_sql_databases.prod", "instance_create_time", ""),
+					resource.TestCheckResourceAttr("data.google_sql_databases.prod", "instance_region", ""),
+					resource.TestCheckResourceAttr("data.google_sql_databases.prod", "instance_tier", ""),
+					resource.TestCheckResourceAttr("data.google_sql_databases.prod", "public_ip_address", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSqlDatabases_labelFilters(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSqlDatabases_labelFilters(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_sql_databases.prod", "databases.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_sql_databases.prod", "databases.0.instance", "google_sql_database_instance.prod", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSqlDatabases_filterByRegion(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSqlDatabases_filterByRegion(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_sql_databases.east", "databases.#", "1"),
+					resource.TestCheckResourceAttrPair("data.google_sql_databases.east", "databases.0.instance", "google_sql_database_instance.east", "name"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.east", "databases.0.region", "us-east1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSqlDatabases_excludeSystemDatabases(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSqlDatabases_excludeSystemDatabases(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.google_sql_databases.all", "databases.*", map[string]string{"name": "sys"}),
+					resource.TestCheckResourceAttr("data.google_sql_databases.user", "databases.#", "1"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.user", "databases.0.name", "mysql-app"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSqlDatabases_noDatabases(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSqlDatabases_noDatabases(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_sql_databases.empty", "databases.#", "0"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.empty", "databases_count", "0"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.empty", "databases_map.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSqlDatabases_instanceSelfLink(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSqlDatabases_instanceSelfLink(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "databases.#", "2"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "databases.0.name", "pg-db1"),
+					resource.TestCheckResourceAttr("data.google_sql_databases.qa", "databases.1.name", "pg-db2"),
+					resource.TestCheckResourceAttrPair("data.google_sql_databases.qa", "databases.0.instance", "google_sql_database_instance.main", "name"),
 				),
 			},
 		},
@@ -75,6 +350,432 @@ data "google_sql_databases" "qa" {
 `, context)
 }
 
+func testAccDataSourceSqlDatabases_instanceSelfLink(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "main" {
+  name             = "tf-test-instance-%{random_suffix}"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database" "db1"{
+	instance = google_sql_database_instance.main.name
+	name = "pg-db1"
+}
+
+resource "google_sql_database" "db2"{
+	instance = google_sql_database_instance.main.name
+	name = "pg-db2"
+}
+
+data "google_sql_databases" "qa" {
+	instance = google_sql_database_instance.main.self_link
+
+	filters {
+		name   = "name"
+		values = ["^pg-db"]
+	}
+
+	depends_on = [
+		google_sql_database.db1,
+		google_sql_database.db2
+	]
+}
+`, context)
+}
+
+func testAccDataSourceSqlDatabases_filterByRegion(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "central" {
+  name             = "tf-test-region-%{random_suffix}-central"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database_instance" "east" {
+  name             = "tf-test-region-%{random_suffix}-east"
+  database_version = "POSTGRES_14"
+  region           = "us-east1"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database" "central"{
+	instance = google_sql_database_instance.central.name
+	name = "pg-app"
+}
+
+resource "google_sql_database" "east"{
+	instance = google_sql_database_instance.east.name
+	name = "pg-app"
+}
+
+data "google_sql_databases" "east" {
+	instance_regex = "^tf-test-region-%{random_suffix}-"
+
+	filters {
+		name   = "name"
+		values = ["^pg-app$"]
+	}
+
+	filters {
+		name   = "region"
+		values = ["^us-east1$"]
+	}
+
+	depends_on = [
+		google_sql_database.central,
+		google_sql_database.east
+	]
+}
+`, context)
+}
+
+func testAccDataSourceSqlDatabases_labelFilters(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "prod" {
+  name             = "tf-test-label-%{random_suffix}-prod"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+
+    user_labels = {
+      environment = "prod"
+    }
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database_instance" "staging" {
+  name             = "tf-test-label-%{random_suffix}-staging"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+
+    user_labels = {
+      environment = "staging"
+    }
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database" "prod" {
+  instance = google_sql_database_instance.prod.name
+  name     = "pg-app"
+}
+
+resource "google_sql_database" "staging" {
+  instance = google_sql_database_instance.staging.name
+  name     = "pg-app"
+}
+
+data "google_sql_databases" "prod" {
+  instance_regex = "^tf-test-label-%{random_suffix}-"
+
+  label_filters {
+    key    = "environment"
+    values = ["^prod$"]
+  }
+
+  filters {
+    name   = "name"
+    values = ["^pg-app$"]
+  }
+
+  depends_on = [
+    google_sql_database.prod,
+    google_sql_database.staging
+  ]
+}
+`, context)
+}
+
+func testAccDataSourceSqlDatabases_excludeSystemDatabases(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "main" {
+  name             = "tf-test-instance-%{random_suffix}"
+  database_version = "MYSQL_8_0"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database" "app"{
+	instance = google_sql_database_instance.main.name
+	name = "mysql-app"
+}
+
+data "google_sql_databases" "all" {
+	instance = google_sql_database_instance.main.name
+	depends_on = [google_sql_database.app]
+}
+
+data "google_sql_databases" "user" {
+	instance                 = google_sql_database_instance.main.name
+	include_system_databases = false
+	depends_on = [google_sql_database.app]
+}
+`, context)
+}
+
+func testAccDataSourceSqlDatabases_noDatabases(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "main" {
+  name             = "tf-test-instance-%{random_suffix}"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+
+  deletion_protection = false
+}
+
+# A new PostgreSQL instance only has the default postgres database.
+data "google_sql_databases" "empty" {
+	instance = google_sql_database_instance.main.name
+
+	filters {
+		name           = "name"
+		exclude_values = ["^postgres$"]
+	}
+
+	timeouts {
+		read = "10m"
+	}
+}
+`, context)
+}
+
+func testAccDataSourceSqlDatabases_filters(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "main" {
+  name             = "tf-test-instance-%{random_suffix}"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database" "db1"{
+	instance = google_sql_database_instance.main.name
+	name = "pg-db1"
+}
+
+resource "google_sql_database" "db2"{
+	instance = google_sql_database_instance.main.name
+	name = "pg-db2"
+}
+
+data "google_sql_databases" "qa" {
+	instance = google_sql_database_instance.main.name
+
+	filters {
+		name   = "name"
+		values = ["^pg-"]
+	}
+
+	filters {
+		name           = "name"
+		exclude_values = ["2$"]
+	}
+
+	depends_on = [
+		google_sql_database.db1,
+		google_sql_database.db2
+	]
+}
+
+output "pg_db1_charset" {
+	value = jsondecode(data.google_sql_databases.qa.databases_map["pg-db1"]).charset
+}
+`, context)
+}
+
+func testAccDataSourceSqlDatabases_database(context map[string]interface{}, database string) string {
+	context["database"] = database
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "main" {
+  name             = "tf-test-instance-%{random_suffix}"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database" "db1"{
+	instance = google_sql_database_instance.main.name
+	name = "pg-db1"
+}
+
+resource "google_sql_database" "db2"{
+	instance = google_sql_database_instance.main.name
+	name = "pg-db2"
+}
+
+data "google_sql_databases" "qa" {
+	instance = google_sql_database_instance.main.name
+	database = "%{database}"
+	depends_on = [
+		google_sql_database.db1,
+		google_sql_database.db2
+	]
+}
+`, context)
+}
+
+func testAccDataSourceSqlDatabases_validateInstance(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+data "google_sql_databases" "missing" {
+	instance          = "tf-test-missing-%{random_suffix}"
+	validate_instance = true
+}
+`, context)
+}
+
+func testAccDataSourceSqlDatabases_invalidRegex(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+data "google_sql_databases" "invalid" {
+  instance = "tf-test-instance-%{random_suffix}"
+
+  filters {
+    name   = "name"
+    values = ["^app-", "pg-["]
+  }
+}
+`, context)
+}
+
+func testAccDataSourceSqlDatabases_instanceRegex(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "prod1" {
+  name             = "tf-test-prod-%{random_suffix}-1"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database_instance" "prod2" {
+  name             = "tf-test-prod-%{random_suffix}-2"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database_instance" "dev" {
+  name             = "tf-test-dev-%{random_suffix}-1"
+  database_version = "POSTGRES_14"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+
+  deletion_protection = false
+}
+
+resource "google_sql_database" "prod1"{
+	instance = google_sql_database_instance.prod1.name
+	name = "pg-app"
+}
+
+resource "google_sql_database" "prod2"{
+	instance = google_sql_database_instance.prod2.name
+	name = "pg-app"
+}
+
+resource "google_sql_database" "dev"{
+	instance = google_sql_database_instance.dev.name
+	name = "pg-app"
+}
+
+data "google_sql_databases" "prod" {
+	instance_regex = "^tf-test-prod-%{random_suffix}-"
+
+	filters {
+		name   = "name"
+		values = ["^pg-app$"]
+	}
+
+	depends_on = [
+		google_sql_database.prod1,
+		google_sql_database.prod2,
+		google_sql_database.dev
+	]
+}
+`, context)
+}
+
+// testAccCheckSqlDatabasesJSONNames checks that databases_json decodes to an
+// array of the databases named names, in order.
+func testAccCheckSqlDatabasesJSONNames(dataSourceName string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("can't find %s in state", dataSourceName)
+		}
+
+		var databases []map[string]interface{}
+		if err := json.Unmarshal([]byte(ds.Primary.Attributes["databases_json"]), &databases); err != nil {
+			return fmt.Errorf("Error decoding databases_json of %s: %s", dataSourceName, err)
+		}
+		if len(databases) != len(names) {
+			return fmt.Errorf("expected %d databases in databases_json, got %d", len(names), len(databases))
+		}
+		for i, name := range names {
+			if databases[i]["name"] != name {
+				return fmt.Errorf("expected database %d in databases_json to be %s, got %v", i, name, databases[i]["name"])
+			}
+		}
+		return nil
+	}
+}
+
 // This function checks data source state matches for resorceName database instance state
 func checkDatabasesListDataSourceStateMatchesResourceStateWithIgnores(dataSourceName, resourceName, resourceName2 string, ignoreFields map[string]struct{}) func(*terraform.State) error {
 	return func(s *terraform.State) error {
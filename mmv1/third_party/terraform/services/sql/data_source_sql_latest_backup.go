@@ -0,0 +1,145 @@
+package sql
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+)
+
+func DataSourceSqlDatabaseInstanceLatestBackup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSqlDatabaseInstanceLatestBackupRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Name of the database instance.`,
+			},
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `Project ID of the project that contains the instance.`,
+			},
+			"backup_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The identifier of the latest successful backup run. Unique only for a specific Cloud SQL instance.`,
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the backup operation actually started in UTC timezone in RFC 3339 format, for example 2012-11-15T16:19:00.094Z.`,
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The time the backup operation completed in UTC timezone in RFC 3339 format, for example 2012-11-15T16:19:00.094Z.`,
+			},
+			"window_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The start time of the backup window during which this backup was attempted in RFC 3339 format, for example 2012-11-15T16:19:00.094Z.`,
+			},
+			"self_link": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The URI of the backup run.`,
+			},
+		},
+	}
+}
+
+func dataSourceSqlDatabaseInstanceLatestBackupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	instance := d.Get("instance").(string)
+
+	var backupRuns []*sqladmin.BackupRun
+	pageToken := ""
+	for {
+		res, err := config.NewSqlAdminClient(userAgent).BackupRuns.List(project, instance).PageToken(pageToken).Do()
+		if err != nil {
+			return transport_tpg.HandleDataSourceNotFoundError(err, d, fmt.Sprintf("Backup runs of %q instance", instance), fmt.Sprintf("Backup runs of %q instance", instance))
+		}
+		backupRuns = append(backupRuns, res.Items...)
+
+		pageToken = res.NextPageToken
+		if pageToken == "" {
+			break
+		}
+	}
+
+	backup, err := latestSuccessfulBackupRun(backupRuns)
+	if err != nil {
+		return err
+	}
+	if backup == nil {
+		return fmt.Errorf("No successful backups found for SQL Database Instance %s", instance)
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("backup_id", backup.Id); err != nil {
+		return fmt.Errorf("Error setting backup_id: %s", err)
+	}
+	if err := d.Set("start_time", backup.StartTime); err != nil {
+		return fmt.Errorf("Error setting start_time: %s", err)
+	}
+	if err := d.Set("end_time", backup.EndTime); err != nil {
+		return fmt.Errorf("Error setting end_time: %s", err)
+	}
+	if err := d.Set("window_start_time", backup.WindowStartTime); err != nil {
+		return fmt.Errorf("Error setting window_start_time: %s", err)
+	}
+	if err := d.Set("self_link", backup.SelfLink); err != nil {
+		return fmt.Errorf("Error setting self_link: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/instances/%s/backupRuns/%d", project, instance, backup.Id))
+	return nil
+}
+
+// latestSuccessfulBackupRun returns the SUCCESSFUL backup run that started
+// last, or nil if there is none.
+func latestSuccessfulBackupRun(backupRuns []*sqladmin.BackupRun) (*sqladmin.BackupRun, error) {
+	type startedBackupRun struct {
+		backup    *sqladmin.BackupRun
+		startTime time.Time
+	}
+
+	var successful []startedBackupRun
+	for _, backup := range backupRuns {
+		if backup.Status != "SUCCESSFUL" {
+			continue
+		}
+		startTime, err := time.Parse(time.RFC3339Nano, backup.StartTime)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing start_time %q of backup run %d: %s", backup.StartTime, backup.Id, err)
+		}
+		successful = append(successful, startedBackupRun{backup: backup, startTime: startTime})
+	}
+	if len(successful) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(successful, func(i, j int) bool {
+		return successful[i].startTime.After(successful[j].startTime)
+	})
+	return successful[0].backup, nil
+}
@@ -0,0 +1,40 @@
+package sql
+
+import (
+	"testing"
+
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+)
+
+func TestLatestSuccessfulBackupRun(t *testing.T) {
+	backupRuns := []*sqladmin.BackupRun{
+		{Id: 1, Status: "SUCCESSFUL", StartTime: "2024-05-01T04:00:00.094Z"},
+		{Id: 2, Status: "FAILED", StartTime: "2024-05-04T04:00:00.094Z"},
+		{Id: 3, Status: "SUCCESSFUL", StartTime: "2024-05-03T04:00:00.5Z"},
+		{Id: 4, Status: "RUNNING", StartTime: "2024-05-05T04:00:00.094Z"},
+		{Id: 5, Status: "SUCCESSFUL", StartTime: "2024-05-02T04:00:00Z"},
+	}
+
+	backup, err := latestSuccessfulBackupRun(backupRuns)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if backup == nil || backup.Id != 3 {
+		t.Errorf("expected backup run 3 to be the latest successful one, got %v", backup)
+	}
+}
+
+func TestLatestSuccessfulBackupRun_noneSuccessful(t *testing.T) {
+	backupRuns := []*sqladmin.BackupRun{
+		{Id: 1, Status: "FAILED", StartTime: "2024-05-01T04:00:00.094Z"},
+		{Id: 2, Status: "ENQUEUED"},
+	}
+
+	backup, err := latestSuccessfulBackupRun(backupRuns)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if backup != nil {
+		t.Errorf("expected no backup run, got %v", backup)
+	}
+}
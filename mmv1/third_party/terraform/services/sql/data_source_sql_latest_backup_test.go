@@ -0,0 +1,99 @@
+package sql_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceSqlDatabaseInstanceLatestBackup_basic(t *testing.T) {
+	// Sqladmin client
+	acctest.SkipIfVcr(t)
+	t.Parallel()
+
+	instance := acctest.BootstrapSharedSQLInstanceBackupRun(t)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSqlDatabaseInstanceLatestBackup_basic(instance),
+				Check: resource.ComposeTestCheckFunc(
+					// The backup runs are listed newest first, so the most recent one
+					// is the latest backup as long as it succeeded.
+					resource.TestCheckResourceAttr("data.google_sql_backup_run.most_recent", "status", "SUCCESSFUL"),
+					resource.TestCheckResourceAttrPair("data.google_sql_database_instance_latest_backup.latest", "backup_id", "data.google_sql_backup_run.most_recent", "backup_id"),
+					resource.TestCheckResourceAttrPair("data.google_sql_database_instance_latest_backup.latest", "start_time", "data.google_sql_backup_run.most_recent", "start_time"),
+					resource.TestCheckResourceAttrSet("data.google_sql_database_instance_latest_backup.latest", "end_time"),
+					resource.TestCheckResourceAttrSet("data.google_sql_database_instance_latest_backup.latest", "window_start_time"),
+					resource.TestMatchResourceAttr("data.google_sql_database_instance_latest_backup.latest", "self_link", regexp.MustCompile("/backupRuns/[0-9]+$")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSqlDatabaseInstanceLatestBackup_notFound(t *testing.T) {
+	// Sqladmin client
+	acctest.SkipIfVcr(t)
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceSqlDatabaseInstanceLatestBackup_notFound(context),
+				ExpectError: regexp.MustCompile("No successful backups found for SQL Database Instance"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSqlDatabaseInstanceLatestBackup_basic(instance string) string {
+	return fmt.Sprintf(`
+data "google_sql_backup_run" "most_recent" {
+  instance    = "%s"
+  most_recent = true
+}
+
+data "google_sql_database_instance_latest_backup" "latest" {
+  instance = "%s"
+}
+`, instance, instance)
+}
+
+func testAccDataSourceSqlDatabaseInstanceLatestBackup_notFound(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_sql_database_instance" "instance" {
+  name             = "tf-test-instance-%{random_suffix}"
+  database_version = "POSTGRES_11"
+  region           = "us-central1"
+
+  settings {
+    tier = "db-f1-micro"
+    backup_configuration {
+      enabled = "false"
+    }
+  }
+
+  deletion_protection = false
+}
+
+data "google_sql_database_instance_latest_backup" "latest" {
+  instance   = google_sql_database_instance.instance.name
+  depends_on = [google_sql_database_instance.instance]
+}
+`, context)
+}
@@ -1,8 +1,12 @@
 package sql
 
 import (
+	"context"
 	"log"
+	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/errwrap"
 	"google.golang.org/api/googleapi"
@@ -24,3 +28,72 @@ func transformSQLDatabaseReadError(err error) error {
 
 	return err
 }
+
+// Retry if the Cloud SQL Admin API returns a 403 whose reason is a rate limit
+// or per-user quota being exceeded. A 429 is already retried by the default
+// retry predicates.
+func isSqlRateLimitError(err error) (bool, string) {
+	gErr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	if !ok {
+		return false, ""
+	}
+	if gErr.Code == 403 {
+		for _, item := range gErr.Errors {
+			if item.Reason == "rateLimitExceeded" || item.Reason == "userRateLimitExceeded" {
+				return true, "Waiting for the Cloud SQL Admin API quota"
+			}
+		}
+	}
+	return false, ""
+}
+
+// sqlRetryAfter returns the delay advised by the Retry-After header of a 429,
+// given either in seconds or as an HTTP date.
+func sqlRetryAfter(err error) (time.Duration, bool) {
+	gErr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	if !ok || gErr.Code != 429 {
+		return 0, false
+	}
+	v := strings.TrimSpace(gErr.Header.Get("Retry-After"))
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second, seconds >= 0
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t), true
+	}
+	return 0, false
+}
+
+// honorSqlRetryAfter wraps retryFunc so that a 429 advising a Retry-After delay
+// waits for that delay before it is retried. The wait never extends past
+// deadline, so the retry still gives up once the timeout is reached.
+func honorSqlRetryAfter(retryFunc func() error, deadline time.Time, sleep func(time.Duration)) func() error {
+	return func() error {
+		err := retryFunc()
+		if delay, ok := sqlRetryAfter(err); ok {
+			if remaining := time.Until(deadline); delay > remaining {
+				delay = remaining
+			}
+			if delay > 0 {
+				log.Printf("[DEBUG] Cloud SQL Admin API rate limit exceeded, retrying after %s", delay)
+				sleep(delay)
+			}
+		}
+		return err
+	}
+}
+
+// sleepContext returns a sleep function that returns early once ctx is done.
+func sleepContext(ctx context.Context) func(time.Duration) {
+	return func(d time.Duration) {
+		t := time.NewTimer(d)
+		defer t.Stop()
+		select {
+		case <-ctx.Done():
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package sql
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+	"google.golang.org/api/googleapi"
+)
+
+func testSqlRateLimitError(retryAfter string) error {
+	header := http.Header{}
+	if retryAfter != "" {
+		header.Set("Retry-After", retryAfter)
+	}
+	return &googleapi.Error{Code: 429, Message: "Quota exceeded", Header: header}
+}
+
+func TestHonorSqlRetryAfter(t *testing.T) {
+	calls := 0
+	retryFunc := func() error {
+		calls++
+		if calls == 1 {
+			return testSqlRateLimitError("3")
+		}
+		return nil
+	}
+
+	var slept []time.Duration
+	sleep := func(d time.Duration) {
+		slept = append(slept, d)
+	}
+
+	err := transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc:            honorSqlRetryAfter(retryFunc, time.Now().Add(time.Minute), sleep),
+		Timeout:              time.Minute,
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{isSqlRateLimitError},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected the rate limited call to be retried once, got %d calls", calls)
+	}
+	if len(slept) != 1 || slept[0] != 3*time.Second {
+		t.Errorf("expected a single 3s wait honoring Retry-After, got %v", slept)
+	}
+}
+
+func TestHonorSqlRetryAfter_deadline(t *testing.T) {
+	var slept []time.Duration
+	sleep := func(d time.Duration) {
+		slept = append(slept, d)
+	}
+
+	retryFunc := honorSqlRetryAfter(func() error {
+		return testSqlRateLimitError("120")
+	}, time.Now().Add(10*time.Second), sleep)
+	if err := retryFunc(); err == nil {
+		t.Fatalf("expected the rate limit error to be returned")
+	}
+	if len(slept) != 1 || slept[0] > 10*time.Second {
+		t.Errorf("expected the wait to be capped at the deadline, got %v", slept)
+	}
+}
+
+func TestSqlRetryAfter(t *testing.T) {
+	cases := map[string]struct {
+		Err      error
+		Expected time.Duration
+		Ok       bool
+	}{
+		"seconds": {
+			Err:      testSqlRateLimitError("5"),
+			Expected: 5 * time.Second,
+			Ok:       true,
+		},
+		"no header": {
+			Err: testSqlRateLimitError(""),
+		},
+		"invalid header": {
+			Err: testSqlRateLimitError("soon"),
+		},
+		"not a rate limit error": {
+			Err: &googleapi.Error{Code: 503, Header: http.Header{"Retry-After": []string{"5"}}},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			got, ok := sqlRetryAfter(tc.Err)
+			if ok != tc.Ok || got != tc.Expected {
+				t.Errorf("expected (%s, %t), got (%s, %t)", tc.Expected, tc.Ok, got, ok)
+			}
+		})
+	}
+
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if got, ok := sqlRetryAfter(testSqlRateLimitError(date)); !ok || got <= 0 || got > time.Minute {
+		t.Errorf("expected an HTTP date Retry-After within a minute, got (%s, %t)", got, ok)
+	}
+}
+
+func TestIsSqlRateLimitError(t *testing.T) {
+	cases := map[string]struct {
+		Err      error
+		Expected bool
+	}{
+		// A 429 is left to the default retry predicates.
+		"rate limit": {
+			Err: testSqlRateLimitError(""),
+		},
+		"quota": {
+			Err:      &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "rateLimitExceeded"}}},
+			Expected: true,
+		},
+		"user quota": {
+			Err:      &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "userRateLimitExceeded"}}},
+			Expected: true,
+		},
+		"permission denied": {
+			Err: &googleapi.Error{Code: 403, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}},
+		},
+		"server error": {
+			Err: &googleapi.Error{Code: 503},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			if got, _ := isSqlRateLimitError(tc.Err); got != tc.Expected {
+				t.Errorf("expected %t, got %t", tc.Expected, got)
+			}
+		})
+	}
+}
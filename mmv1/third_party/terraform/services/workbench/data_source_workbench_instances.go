@@ -0,0 +1,153 @@
+package workbench
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-google/google/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google/google/transport"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceWorkbenchInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceWorkbenchInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The ID of the project in which the instances are located. If it is not provided, the provider project is used.`,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `The zone of the instances. If it is not provided, the instances of every zone of the project are listed.`,
+			},
+			"filters": tpgresource.DatasourceFiltersSchema("name", "state", "machine_type"),
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The full resource name of the instance.`,
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The state of the instance, such as ACTIVE or STOPPED.`,
+						},
+						"machine_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The machine type of the Compute Engine VM of the instance, from gce_setup.machine_type.`,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The labels of the instance, including labels configured outside of Terraform.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWorkbenchInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return fmt.Errorf("Error fetching project for Workbench instances: %s", err)
+	}
+	billingProject := project
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	filters, err := tpgresource.ExpandDatasourceFilters(d)
+	if err != nil {
+		return err
+	}
+
+	location := d.Get("location").(string)
+	if location == "" {
+		// The "-" wildcard lists the instances of every zone in one request.
+		location = "-"
+	}
+
+	items, err := listWorkbenchInstances(config, billingProject, project, location, userAgent)
+	if err != nil {
+		return fmt.Errorf("Error listing Workbench instances: %s", err)
+	}
+	instances := flattenWorkbenchInstances(items)
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("instances", tpgresource.ApplyDatasourceFilters(filters, instances)); err != nil {
+		return fmt.Errorf("Error setting Workbench instances: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/instances", project, location))
+
+	return nil
+}
+
+// listWorkbenchInstances returns the instances of a single location.
+func listWorkbenchInstances(config *transport_tpg.Config, billingProject, project, location, userAgent string) ([]interface{}, error) {
+	url := fmt.Sprintf("%sprojects/%s/locations/%s/instances", config.WorkbenchBasePath, project, location)
+	return transport_tpg.ListAllPages(func(pageToken string) ([]interface{}, string, error) {
+		res, nextPageToken, err := transport_tpg.SendListRequest(config, billingProject, url, userAgent, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		items, _ := res["instances"].([]interface{})
+		return items, nextPageToken, nil
+	}, transport_tpg.SendRequestRetryOptions)
+}
+
+func flattenWorkbenchInstances(items []interface{}) []map[string]interface{} {
+	instances := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		instance, ok := item.(map[string]interface{})
+		if !ok || len(instance) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+
+		instances = append(instances, map[string]interface{}{
+			"name":         instance["name"],
+			"state":        instance["state"],
+			"machine_type": flattenWorkbenchInstancesMachineType(instance),
+			"labels":       instance["labels"],
+		})
+	}
+	return instances
+}
+
+// flattenWorkbenchInstancesMachineType returns the machine type of the
+// instance's gce_setup, which may be a short name or a full URL.
+func flattenWorkbenchInstancesMachineType(v map[string]interface{}) interface{} {
+	gceSetup, ok := v["gceSetup"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	machineType, ok := gceSetup["machineType"].(string)
+	if !ok {
+		return nil
+	}
+	return tpgresource.GetResourceNameFromSelfLink(machineType)
+}
@@ -0,0 +1,94 @@
+package workbench_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-google/google/acctest"
+)
+
+func TestAccDataSourceWorkbenchInstances_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(t, 10),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceWorkbenchInstances_basic(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_workbench_instances.filtered", "instances.#", "1"),
+					resource.TestMatchResourceAttr("data.google_workbench_instances.filtered", "instances.0.name", regexp.MustCompile("/locations/us-central1-a/instances/tf-test-workbench-instance"+context["random_suffix"].(string)+"$")),
+					resource.TestCheckResourceAttr("data.google_workbench_instances.filtered", "instances.0.state", "ACTIVE"),
+					resource.TestCheckResourceAttr("data.google_workbench_instances.filtered", "instances.0.machine_type", "e2-standard-4"),
+					resource.TestCheckResourceAttr("data.google_workbench_instances.filtered", "instances.0.labels.environment", "dev"),
+					resource.TestCheckResourceAttr("data.google_workbench_instances.all_locations", "instances.#", "1"),
+					resource.TestCheckResourceAttr("data.google_workbench_instances.other_machine_type", "instances.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceWorkbenchInstances_basic(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_workbench_instance" "instance" {
+  name     = "tf-test-workbench-instance%{random_suffix}"
+  location = "us-central1-a"
+
+  gce_setup {
+    machine_type = "e2-standard-4"
+  }
+
+  labels = {
+    environment = "dev"
+  }
+}
+
+data "google_workbench_instances" "filtered" {
+  location = "us-central1-a"
+
+  filters {
+    name   = "name"
+    values = ["/instances/tf-test-workbench-instance%{random_suffix}$"]
+  }
+
+  filters {
+    name           = "state"
+    literal_values = ["ACTIVE"]
+  }
+
+  depends_on = [google_workbench_instance.instance]
+}
+
+data "google_workbench_instances" "all_locations" {
+  filters {
+    name   = "name"
+    values = ["/instances/tf-test-workbench-instance%{random_suffix}$"]
+  }
+
+  depends_on = [google_workbench_instance.instance]
+}
+
+data "google_workbench_instances" "other_machine_type" {
+  location = "us-central1-a"
+
+  filters {
+    name   = "name"
+    values = ["/instances/tf-test-workbench-instance%{random_suffix}$"]
+  }
+
+  filters {
+    name   = "machine_type"
+    values = ["^n1-"]
+  }
+
+  depends_on = [google_workbench_instance.instance]
+}
+`, context)
+}
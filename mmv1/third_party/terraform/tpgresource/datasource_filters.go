@@ -0,0 +1,380 @@
+package tpgresource
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-google/google/verify"
+)
+
+// DatasourceFilter is a compiled `filters` block of a list data source.
+type DatasourceFilter struct {
+	Name          string
+	Values        []*regexp.Regexp
+	LiteralValues []string
+	IgnoreCase    bool
+	ExcludeValues []*regexp.Regexp
+	// SegmentDelimiter, when set, splits the attribute into segments that are
+	// matched individually instead of matching the whole string.
+	SegmentDelimiter string
+	// Negate inverts the result of the filter once its values and exclude
+	// values are evaluated.
+	Negate bool
+	// MatchEmpty keeps items whose attribute is empty, in addition to those
+	// matching Values or LiteralValues.
+	MatchEmpty bool
+}
+
+// String summarizes the filter for diagnostics, for example
+// `name values=[^app-] exclude_values=[-test$]`.
+func (f *DatasourceFilter) String() string {
+	parts := []string{f.Name}
+	if len(f.Values) > 0 {
+		parts = append(parts, fmt.Sprintf("values=%s", regexpsString(f.Values)))
+	}
+	if len(f.LiteralValues) > 0 {
+		parts = append(parts, fmt.Sprintf("literal_values=[%s]", strings.Join(f.LiteralValues, ", ")))
+	}
+	if len(f.ExcludeValues) > 0 {
+		parts = append(parts, fmt.Sprintf("exclude_values=%s", regexpsString(f.ExcludeValues)))
+	}
+	if f.SegmentDelimiter != "" {
+		parts = append(parts, fmt.Sprintf("segment_delimiter=%q", f.SegmentDelimiter))
+	}
+	if f.MatchEmpty {
+		parts = append(parts, "match_empty")
+	}
+	if f.Negate {
+		parts = append(parts, "negate")
+	}
+	return strings.Join(parts, " ")
+}
+
+func regexpsString(patterns []*regexp.Regexp) string {
+	values := make([]string, 0, len(patterns))
+	for _, re := range patterns {
+		values = append(values, re.String())
+	}
+	return "[" + strings.Join(values, ", ") + "]"
+}
+
+// DatasourceFiltersSchema returns the schema for the repeatable `filters`
+// block shared by list data sources. fields lists the attribute names of a
+// listed item that a filter may target.
+func DatasourceFiltersSchema(fields ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Description: `Client-side filters applied to the listed items. An item is returned
+only if it satisfies every filters block.`,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateDatasourceFilterName(fields),
+					Description:  `The name of the attribute to filter on, or a comma-separated list of names such as "name,collation" to keep items where any of those attributes match.`,
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        datasourceFilterRegexElem(),
+					Description: `RE2 regular expressions matched against the attribute. The item is kept if any of them match.`,
+				},
+				"literal_values": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: `Exact values compared with the attribute. The item is kept if any of them, or any of values, match.`,
+				},
+				"ignore_case": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: `Compare literal_values with the attribute case-insensitively. Regular expressions can use the (?i) flag instead.`,
+				},
+				"exclude_values": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        datasourceFilterRegexElem(),
+					Description: `RE2 regular expressions matched against the attribute. The item is dropped if any of them match.`,
+				},
+				"segment_delimiter": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: `Split the attribute on this delimiter and match every segment individually, for example "/" for resource names and self links. The item is kept if any segment matches, and dropped if any segment matches exclude_values. By default the whole attribute is matched.`,
+				},
+				"match_empty": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: `Keep items whose attribute is empty, or an empty list, in addition to those matching values or literal_values. Without values or literal_values, only items with an empty attribute are kept.`,
+				},
+				"negate": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: `Invert this filters block. The block first keeps an item if it matches values or literal_values and none of exclude_values, then negate inverts that decision. Every filters block, negated or not, must still be satisfied.`,
+				},
+			},
+		},
+	}
+}
+
+// validateDatasourceFilterName checks that every attribute named by a filter,
+// which may be a comma-separated list, is one of fields.
+func validateDatasourceFilterName(fields []string) schema.SchemaValidateFunc {
+	inFields := validation.StringInSlice(fields, false)
+	return func(v interface{}, k string) (ws []string, errs []error) {
+		for _, name := range strings.Split(v.(string), ",") {
+			w, e := inFields(strings.TrimSpace(name), k)
+			ws = append(ws, w...)
+			errs = append(errs, e...)
+		}
+		return
+	}
+}
+
+// datasourceFilterRegexElem is the element schema of the filter fields holding
+// regular expressions, which are compiled at plan time so that syntax errors
+// surface before the data source is read. literal_values are not regular
+// expressions and are not validated.
+func datasourceFilterRegexElem() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		ValidateDiagFunc: validation.ToDiagFunc(verify.ValidateRegexCompiles()),
+	}
+}
+
+// ExpandDatasourceFilters compiles the `filters` blocks configured on d.
+func ExpandDatasourceFilters(d TerraformResourceData) ([]*DatasourceFilter, error) {
+	raw := d.Get("filters").([]interface{})
+	filters := make([]*DatasourceFilter, 0, len(raw))
+	for _, r := range raw {
+		if r == nil {
+			continue
+		}
+		block := r.(map[string]interface{})
+		filter := &DatasourceFilter{
+			Name: block["name"].(string),
+		}
+		if v, ok := block["literal_values"].([]interface{}); ok {
+			for _, literal := range v {
+				value, _ := literal.(string)
+				filter.LiteralValues = append(filter.LiteralValues, value)
+			}
+		}
+		filter.IgnoreCase, _ = block["ignore_case"].(bool)
+		filter.SegmentDelimiter, _ = block["segment_delimiter"].(string)
+		filter.Negate, _ = block["negate"].(bool)
+		filter.MatchEmpty, _ = block["match_empty"].(bool)
+
+		// A pattern listed in both values and exclude_values is compiled once.
+		compiled := make(map[string]*regexp.Regexp)
+		var err error
+		if filter.Values, err = compileDatasourceFilterValues(filter.Name, block["values"], compiled); err != nil {
+			return nil, err
+		}
+		if filter.ExcludeValues, err = compileDatasourceFilterValues(filter.Name, block["exclude_values"], compiled); err != nil {
+			return nil, err
+		}
+		if overlapping := filter.OverlappingPatterns(); len(overlapping) > 0 {
+			log.Printf("[WARN] Filter %q lists %q in both values and exclude_values", filter.Name, overlapping)
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// compileDatasourceFilterValues compiles the patterns of v, reusing and
+// recording the patterns already compiled for the same block in shared.
+func compileDatasourceFilterValues(name string, v interface{}, shared map[string]*regexp.Regexp) ([]*regexp.Regexp, error) {
+	raw, _ := v.([]interface{})
+	compiled := make([]*regexp.Regexp, 0, len(raw))
+	for _, r := range raw {
+		pattern, _ := r.(string)
+		re, ok := shared[pattern]
+		if !ok {
+			var err error
+			if re, err = regexp.Compile(pattern); err != nil {
+				return nil, fmt.Errorf("Error compiling filter %q value %q: %s", name, pattern, err)
+			}
+			shared[pattern] = re
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// OverlappingPatterns returns the patterns listed in both values and
+// exclude_values. Items matching such a pattern are always dropped, which is
+// almost certainly not what was intended.
+func (f *DatasourceFilter) OverlappingPatterns() []string {
+	values := make(map[string]struct{}, len(f.Values))
+	for _, re := range f.Values {
+		values[re.String()] = struct{}{}
+	}
+	overlapping := make([]string, 0)
+	for _, re := range f.ExcludeValues {
+		if _, ok := values[re.String()]; ok {
+			overlapping = append(overlapping, re.String())
+		}
+	}
+	return overlapping
+}
+
+// DatasourceFilterWarnings returns a warning for every filter listing the same
+// pattern in both values and exclude_values. The filters are still applied as
+// configured.
+func DatasourceFilterWarnings(filters []*DatasourceFilter) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, filter := range filters {
+		overlapping := filter.OverlappingPatterns()
+		if len(overlapping) == 0 {
+			continue
+		}
+		detail := fmt.Sprintf("Items matching %q are always dropped by exclude_values.", overlapping)
+		if len(overlapping) == len(filter.Values) && len(filter.LiteralValues) == 0 && !filter.MatchEmpty && !filter.Negate {
+			detail += " As every pattern of values is excluded, the filter can never match anything."
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Filter %q lists the same pattern in values and exclude_values", filter.Name),
+			Detail:   detail,
+		})
+	}
+	return diags
+}
+
+// RegexMatch reports whether an item satisfies every filter. get returns the
+// string value of the named attribute of the item being evaluated.
+func RegexMatch(filters []*DatasourceFilter, get func(name string) string) bool {
+	return regexMatchValues(filters, func(name string) []string {
+		return []string{get(name)}
+	})
+}
+
+// regexMatchValues is RegexMatch for attributes that may hold several values,
+// such as lists of regions. A filter matches such an attribute if any of its
+// values match.
+func regexMatchValues(filters []*DatasourceFilter, get func(name string) []string) bool {
+	for _, filter := range filters {
+		values := make([]string, 0)
+		for _, name := range filter.fields() {
+			values = append(values, get(name)...)
+		}
+		if !filter.matches(values) {
+			return false
+		}
+	}
+	return true
+}
+
+// fields returns the attributes the filter targets. A filter naming several
+// attributes, as in "name,collation", matches if any of them match, just like
+// an attribute holding several values.
+func (f *DatasourceFilter) fields() []string {
+	names := strings.Split(f.Name, ",")
+	for i, name := range names {
+		names[i] = strings.TrimSpace(name)
+	}
+	return names
+}
+
+// matches reports whether an attribute holding values satisfies the filter.
+// An attribute without values, such as an empty list, is matched as the empty
+// string, so that it behaves like an empty string attribute: "^$" and ".*"
+// match both.
+func (f *DatasourceFilter) matches(values []string) bool {
+	if len(values) == 0 {
+		values = []string{""}
+	}
+	empty := matchesAnyLiteral([]string{""}, values, false)
+	values = f.segments(values)
+	// Every block starts from include, so a block with only exclude_values
+	// keeps whatever it does not exclude, whatever the other blocks decided.
+	include := true
+	if len(f.Values) > 0 || len(f.LiteralValues) > 0 || f.MatchEmpty {
+		include = matchesAnyRegex(f.Values, values) || matchesAnyLiteral(f.LiteralValues, values, f.IgnoreCase) || (f.MatchEmpty && empty)
+	}
+	if matchesAnyRegex(f.ExcludeValues, values) {
+		include = false
+	}
+	return include != f.Negate
+}
+
+// segments splits values on the filter's segment delimiter, returning values
+// unchanged when it has none.
+func (f *DatasourceFilter) segments(values []string) []string {
+	if f.SegmentDelimiter == "" {
+		return values
+	}
+	segments := make([]string, 0, len(values))
+	for _, value := range values {
+		segments = append(segments, strings.Split(value, f.SegmentDelimiter)...)
+	}
+	return segments
+}
+
+// ApplyDatasourceFilters returns the flattened items that satisfy filters,
+// reading each filtered attribute from the item's top-level keys. List
+// attributes match if any of their elements match.
+func ApplyDatasourceFilters(filters []*DatasourceFilter, items []map[string]interface{}) []map[string]interface{} {
+	if len(filters) == 0 {
+		return items
+	}
+
+	filtered := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if regexMatchValues(filters, flattenedItemAttribute(item)) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+func flattenedItemAttribute(item map[string]interface{}) func(string) []string {
+	return func(name string) []string {
+		switch v := item[name].(type) {
+		case nil:
+			return []string{""}
+		case string:
+			return []string{v}
+		case []string:
+			return v
+		case []interface{}:
+			values := make([]string, 0, len(v))
+			for _, e := range v {
+				values = append(values, fmt.Sprintf("%v", e))
+			}
+			return values
+		default:
+			return []string{fmt.Sprintf("%v", v)}
+		}
+	}
+}
+
+func matchesAnyRegex(patterns []*regexp.Regexp, values []string) bool {
+	for _, re := range patterns {
+		for _, value := range values {
+			if re.MatchString(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchesAnyLiteral(literals, values []string, ignoreCase bool) bool {
+	for _, literal := range literals {
+		for _, value := range values {
+			if literal == value || (ignoreCase && strings.EqualFold(literal, value)) {
+				return true
+			}
+		}
+	}
+	return false
+}
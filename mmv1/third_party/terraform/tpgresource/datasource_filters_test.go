@@ -0,0 +1,485 @@
+package tpgresource
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestRegexMatch(t *testing.T) {
+	item := map[string]interface{}{
+		"name":  "prod-fleet",
+		"state": "READY",
+	}
+
+	cases := map[string]struct {
+		Filters  []interface{}
+		Expected bool
+	}{
+		"no filters": {
+			Expected: true,
+		},
+		"value matches": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name", "values": []interface{}{"^prod-"}},
+			},
+			Expected: true,
+		},
+		"no value matches": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name", "values": []interface{}{"^dev-", "^test-"}},
+			},
+			Expected: false,
+		},
+		"exclude value matches": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name", "values": []interface{}{"fleet"}, "exclude_values": []interface{}{"^prod-"}},
+			},
+			Expected: false,
+		},
+		"any of several attributes matches": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name, state", "values": []interface{}{"^READY$"}},
+			},
+			Expected: true,
+		},
+		"none of several attributes matches": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name,state", "values": []interface{}{"^dev-", "^CREATING$"}},
+			},
+			Expected: false,
+		},
+		"exclude value matches one of several attributes": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name,state", "values": []interface{}{"^prod-"}, "exclude_values": []interface{}{"^READY$"}},
+			},
+			Expected: false,
+		},
+		"every block must match": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name", "values": []interface{}{"^prod-"}},
+				map[string]interface{}{"name": "state", "values": []interface{}{"^CREATING$"}},
+			},
+			Expected: false,
+		},
+		"exclude-only block without a match": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name", "exclude_values": []interface{}{"^dev-"}},
+			},
+			Expected: true,
+		},
+		"exclude-only block with a match": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name", "exclude_values": []interface{}{"^prod-"}},
+			},
+			Expected: false,
+		},
+		"exclude-only block first": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "state", "exclude_values": []interface{}{"^CREATING$"}},
+				map[string]interface{}{"name": "name", "values": []interface{}{"^prod-"}},
+			},
+			Expected: true,
+		},
+		"exclude-only block first with a match": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "state", "exclude_values": []interface{}{"^READY$"}},
+				map[string]interface{}{"name": "name", "values": []interface{}{"^prod-"}},
+			},
+			Expected: false,
+		},
+		"exclude-only block after a matching block": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name", "values": []interface{}{"^prod-"}},
+				map[string]interface{}{"name": "state", "exclude_values": []interface{}{"^CREATING$"}},
+			},
+			Expected: true,
+		},
+		"exclude-only block after a block without a match": {
+			Filters: []interface{}{
+				map[string]interface{}{"name": "name", "values": []interface{}{"^dev-"}},
+				map[string]interface{}{"name": "state", "exclude_values": []interface{}{"^CREATING$"}},
+			},
+			Expected: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				"filters": DatasourceFiltersSchema("name", "state"),
+			}, map[string]interface{}{"filters": tc.Filters})
+
+			filters, err := ExpandDatasourceFilters(d)
+			if err != nil {
+				t.Fatalf("unexpected error expanding filters: %s", err)
+			}
+			got := RegexMatch(filters, func(name string) string {
+				return item[name].(string)
+			})
+			if got != tc.Expected {
+				t.Errorf("expected RegexMatch to return %t, got %t", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestApplyDatasourceFilters_listAttribute(t *testing.T) {
+	items := []map[string]interface{}{
+		{"tier": "db-f1-micro", "region": []string{"us-central1", "us-east1"}},
+		{"tier": "db-g1-small", "region": []string{"europe-west1"}},
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"filters": DatasourceFiltersSchema("tier", "region"),
+	}, map[string]interface{}{
+		"filters": []interface{}{
+			map[string]interface{}{"name": "region", "values": []interface{}{"^us-east1$"}},
+		},
+	})
+
+	filters, err := ExpandDatasourceFilters(d)
+	if err != nil {
+		t.Fatalf("unexpected error expanding filters: %s", err)
+	}
+
+	filtered := ApplyDatasourceFilters(filters, items)
+	if len(filtered) != 1 || filtered[0]["tier"] != "db-f1-micro" {
+		t.Errorf("expected only db-f1-micro to be available in us-east1, got %v", filtered)
+	}
+}
+
+func TestExpandDatasourceFilters_invalidRegex(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"filters": DatasourceFiltersSchema("name"),
+	}, map[string]interface{}{
+		"filters": []interface{}{
+			map[string]interface{}{"name": "name", "values": []interface{}{"prod-["}},
+		},
+	})
+
+	if _, err := ExpandDatasourceFilters(d); err == nil {
+		t.Fatal("expected an error compiling an invalid regular expression")
+	}
+}
+
+func TestExpandDatasourceFilters_overlappingPatterns(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"filters": DatasourceFiltersSchema("name", "tier"),
+	}, map[string]interface{}{
+		"filters": []interface{}{
+			map[string]interface{}{
+				"name":           "name",
+				"values":         []interface{}{"^prod-"},
+				"exclude_values": []interface{}{"^prod-"},
+			},
+			map[string]interface{}{
+				"name":           "tier",
+				"values":         []interface{}{"^db-"},
+				"exclude_values": []interface{}{"micro$"},
+			},
+		},
+	})
+
+	filters, err := ExpandDatasourceFilters(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if filters[0].Values[0] != filters[0].ExcludeValues[0] {
+		t.Error("expected the overlapping pattern to be compiled once")
+	}
+
+	diags := DatasourceFilterWarnings(filters)
+	if len(diags) != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning, got severity %v", diags[0].Severity)
+	}
+	if !strings.Contains(diags[0].Summary, `"name"`) || !strings.Contains(diags[0].Detail, "^prod-") || !strings.Contains(diags[0].Detail, "can never match anything") {
+		t.Errorf("expected the warning to name the filter and the overlapping pattern, got %q: %q", diags[0].Summary, diags[0].Detail)
+	}
+
+	// The filters are still applied as configured.
+	items := []map[string]interface{}{{"name": "prod-a", "tier": "db-g1-small"}}
+	if filtered := ApplyDatasourceFilters(filters, items); len(filtered) != 0 {
+		t.Errorf("expected the overlapping filter to match nothing, got %v", filtered)
+	}
+}
+
+func TestDatasourceFiltersSchema_validatesRegex(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"filters": DatasourceFiltersSchema("name"),
+		},
+	}
+
+	cases := map[string]struct {
+		filter    map[string]interface{}
+		expectErr bool
+	}{
+		"valid values": {
+			filter: map[string]interface{}{"name": "name", "values": []interface{}{"^prod-", "(?i)-db$"}},
+		},
+		"invalid values": {
+			filter:    map[string]interface{}{"name": "name", "values": []interface{}{"^prod-", "prod-["}},
+			expectErr: true,
+		},
+		"invalid exclude_values": {
+			filter:    map[string]interface{}{"name": "name", "exclude_values": []interface{}{"(test"}},
+			expectErr: true,
+		},
+		"literal_values are not regular expressions": {
+			filter: map[string]interface{}{"name": "name", "literal_values": []interface{}{"prod-[1]", "(test"}},
+		},
+	}
+	for tn, tc := range cases {
+		diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+			"filters": []interface{}{tc.filter},
+		}))
+		if diags.HasError() != tc.expectErr {
+			t.Errorf("%s: expected an error: %t, got %v", tn, tc.expectErr, diags)
+		}
+	}
+}
+
+func TestDatasourceFiltersSchema_validatesName(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"filters": DatasourceFiltersSchema("name", "state"),
+		},
+	}
+
+	cases := map[string]struct {
+		name      string
+		expectErr bool
+	}{
+		"single attribute": {
+			name: "name",
+		},
+		"several attributes": {
+			name: "name, state",
+		},
+		"unknown attribute": {
+			name:      "tier",
+			expectErr: true,
+		},
+		"unknown attribute among several": {
+			name:      "name,tier",
+			expectErr: true,
+		},
+		"empty attribute among several": {
+			name:      "name,",
+			expectErr: true,
+		},
+	}
+	for tn, tc := range cases {
+		diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+			"filters": []interface{}{map[string]interface{}{"name": tc.name, "values": []interface{}{"^prod-"}}},
+		}))
+		if diags.HasError() != tc.expectErr {
+			t.Errorf("%s: expected an error: %t, got %v", tn, tc.expectErr, diags)
+		}
+	}
+}
+
+func TestDatasourceFilterString(t *testing.T) {
+	filter := &DatasourceFilter{
+		Name:          "name",
+		Values:        []*regexp.Regexp{regexp.MustCompile("^app-"), regexp.MustCompile("^web-")},
+		ExcludeValues: []*regexp.Regexp{regexp.MustCompile("-test$")},
+	}
+	if got, want := filter.String(), "name values=[^app-, ^web-] exclude_values=[-test$]"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestRegexMatch_literalValues(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"filters": DatasourceFiltersSchema("name"),
+	}, map[string]interface{}{
+		"filters": []interface{}{
+			map[string]interface{}{
+				"name":           "name",
+				"values":         []interface{}{"^app-"},
+				"literal_values": []interface{}{"billing.db", "Orders"},
+				"ignore_case":    true,
+			},
+		},
+	})
+	filters, err := ExpandDatasourceFilters(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cases := map[string]bool{
+		// Matched by the regular expression.
+		"app-users": true,
+		// Matched literally, so the "." is not a wildcard.
+		"billing.db": true,
+		"billingxdb": false,
+		// Matched literally ignoring case.
+		"orders":     true,
+		"orders-old": false,
+		"reports":    false,
+	}
+	for name, expected := range cases {
+		got := RegexMatch(filters, func(string) string { return name })
+		if got != expected {
+			t.Errorf("expected %q to match %t, got %t", name, expected, got)
+		}
+	}
+}
+
+func TestApplyDatasourceFilters_segmentDelimiter(t *testing.T) {
+	items := []map[string]interface{}{
+		{"self_link": "https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1/subnetworks/app"},
+		{"self_link": "https://www.googleapis.com/compute/v1/projects/my-project/regions/us-east1/subnetworks/test-app"},
+		{"self_link": "https://www.googleapis.com/compute/v1/projects/my-project-test/regions/us-east1/subnetworks/db"},
+		{"self_link": "https://www.googleapis.com/compute/v1/projects/my-project/regions/us-east1/subnetworks/db"},
+	}
+
+	cases := map[string]struct {
+		filter   *DatasourceFilter
+		expected []int
+	}{
+		"whole string exclude": {
+			filter: &DatasourceFilter{
+				Name:          "self_link",
+				ExcludeValues: []*regexp.Regexp{regexp.MustCompile("^test-")},
+			},
+			expected: []int{0, 1, 2, 3},
+		},
+		"segment exclude": {
+			filter: &DatasourceFilter{
+				Name:             "self_link",
+				ExcludeValues:    []*regexp.Regexp{regexp.MustCompile("^test-"), regexp.MustCompile("-test$")},
+				SegmentDelimiter: "/",
+			},
+			expected: []int{0, 3},
+		},
+		"segment include": {
+			filter: &DatasourceFilter{
+				Name:             "self_link",
+				LiteralValues:    []string{"us-central1", "test-app"},
+				SegmentDelimiter: "/",
+			},
+			expected: []int{0, 1},
+		},
+		"segment include and exclude": {
+			filter: &DatasourceFilter{
+				Name:             "self_link",
+				LiteralValues:    []string{"db"},
+				ExcludeValues:    []*regexp.Regexp{regexp.MustCompile("^my-project-")},
+				SegmentDelimiter: "/",
+			},
+			expected: []int{3},
+		},
+	}
+	for tn, tc := range cases {
+		got := ApplyDatasourceFilters([]*DatasourceFilter{tc.filter}, items)
+		expected := make([]map[string]interface{}, 0, len(tc.expected))
+		for _, i := range tc.expected {
+			expected = append(expected, items[i])
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: expected %v, got %v", tn, expected, got)
+		}
+	}
+}
+
+func TestApplyDatasourceFilters_negate(t *testing.T) {
+	items := []map[string]interface{}{
+		{"name": "app-users", "charset": "UTF8"},
+		{"name": "app-orders", "charset": "LATIN1"},
+		{"name": "reports", "charset": "UTF8"},
+		{"name": "postgres", "charset": "UTF8"},
+	}
+	names := &DatasourceFilter{
+		Name:          "name",
+		Values:        []*regexp.Regexp{regexp.MustCompile("^app-")},
+		LiteralValues: []string{"postgres"},
+		ExcludeValues: []*regexp.Regexp{regexp.MustCompile("-orders$")},
+	}
+	negated := *names
+	negated.Negate = true
+
+	if got, expected := ApplyDatasourceFilters([]*DatasourceFilter{names}, items), []map[string]interface{}{items[0], items[3]}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	// The negated block returns the complement of the block.
+	if got, expected := ApplyDatasourceFilters([]*DatasourceFilter{&negated}, items), []map[string]interface{}{items[1], items[2]}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected the complement %v, got %v", expected, got)
+	}
+
+	// Other blocks still apply to the complement.
+	charset := &DatasourceFilter{
+		Name:   "charset",
+		Values: []*regexp.Regexp{regexp.MustCompile("^UTF8$")},
+	}
+	if got, expected := ApplyDatasourceFilters([]*DatasourceFilter{&negated, charset}, items), []map[string]interface{}{items[2]}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestApplyDatasourceFilters_emptyValues(t *testing.T) {
+	items := []map[string]interface{}{
+		{"name": "app", "collation": "en_US.UTF8", "regions": []interface{}{"us-central1"}},
+		{"name": "reports", "collation": "", "regions": []interface{}{}},
+		{"name": "postgres", "collation": nil, "regions": nil},
+	}
+
+	cases := map[string]struct {
+		filter   *DatasourceFilter
+		expected []int
+	}{
+		"any string matches empty attributes": {
+			filter:   &DatasourceFilter{Name: "regions", Values: []*regexp.Regexp{regexp.MustCompile(".*")}},
+			expected: []int{0, 1, 2},
+		},
+		"empty string matches empty lists": {
+			filter:   &DatasourceFilter{Name: "regions", Values: []*regexp.Regexp{regexp.MustCompile("^$")}},
+			expected: []int{1, 2},
+		},
+		"match_empty alone": {
+			filter:   &DatasourceFilter{Name: "collation", MatchEmpty: true},
+			expected: []int{1, 2},
+		},
+		"match_empty on a list attribute": {
+			filter:   &DatasourceFilter{Name: "regions", MatchEmpty: true},
+			expected: []int{1, 2},
+		},
+		"match_empty with values": {
+			filter: &DatasourceFilter{
+				Name:       "collation",
+				Values:     []*regexp.Regexp{regexp.MustCompile("^en_US")},
+				MatchEmpty: true,
+			},
+			expected: []int{0, 1, 2},
+		},
+		"match_empty with segments": {
+			filter:   &DatasourceFilter{Name: "collation", MatchEmpty: true, SegmentDelimiter: "."},
+			expected: []int{1, 2},
+		},
+		"negated match_empty": {
+			filter:   &DatasourceFilter{Name: "collation", MatchEmpty: true, Negate: true},
+			expected: []int{0},
+		},
+	}
+	for tn, tc := range cases {
+		got := ApplyDatasourceFilters([]*DatasourceFilter{tc.filter}, items)
+		expected := make([]map[string]interface{}, 0, len(tc.expected))
+		for _, i := range tc.expected {
+			expected = append(expected, items[i])
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: expected %v, got %v", tn, expected, got)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package transport
+
+import (
+	"log"
+)
+
+// ListAllPages lists every page of a paginated API and returns their items in
+// order. fetch is called with the token returned for the previous page,
+// starting from "", until it returns an empty token. Each call to fetch is
+// retried on its own with the options retryOptions returns for it, so a
+// transient error only fetches the failing page again instead of restarting
+// the listing.
+func ListAllPages[T any](fetch func(pageToken string) ([]T, string, error), retryOptions func(retryFunc func() error) RetryOptions) ([]T, error) {
+	all := make([]T, 0)
+	pageToken := ""
+	for {
+		var items []T
+		var nextPageToken string
+		err := Retry(retryOptions(func() (rerr error) {
+			items, nextPageToken, rerr = fetch(pageToken)
+			return rerr
+		}))
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+
+		if nextPageToken == "" {
+			return all, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+// SendListRequest sends a GET of one page of the list at url, the page after
+// pageToken, and returns the response and the token of the next page, which
+// is "" on the last page.
+func SendListRequest(config *Config, billingProject, url, userAgent, pageToken string) (map[string]interface{}, string, error) {
+	params := make(map[string]string)
+	if pageToken != "" {
+		params["pageToken"] = pageToken
+	}
+	listUrl, err := AddQueryParams(url, params)
+	if err != nil {
+		return nil, "", err
+	}
+
+	res, err := SendRequest(SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   billingProject,
+		RawURL:    listUrl,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	nextPageToken, _ := res["nextPageToken"].(string)
+	return res, nextPageToken, nil
+}
+
+// SendRequestRetryOptions are the ListAllPages retry options of fetch
+// functions calling SendRequest, which already retries each request, so a
+// failing page is not retried again.
+func SendRequestRetryOptions(retryFunc func() error) RetryOptions {
+	return RetryOptions{
+		RetryFunc: retryFunc,
+		ErrorAbortPredicates: []RetryErrorPredicateFunc{
+			func(error) (bool, string) { return true, "SendRequest already retried the request" },
+		},
+	}
+}
+
+// ListLocationIds returns the IDs of the locations of a project listed at url,
+// such as projects/my-project/locations, following every page. The IDs are
+// read from the idKey attribute of each entry of the itemsKey list, which is
+// locationId in locations for services implementing the Locations API.
+func ListLocationIds(config *Config, billingProject, url, userAgent, itemsKey, idKey string) ([]string, error) {
+	return ListAllPages(func(pageToken string) ([]string, string, error) {
+		res, nextPageToken, err := SendListRequest(config, billingProject, url, userAgent, pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		ids := make([]string, 0)
+		items, _ := res[itemsKey].([]interface{})
+		for _, item := range items {
+			location, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if id, ok := location[idKey].(string); ok && id != "" {
+				ids = append(ids, id)
+			}
+		}
+		return ids, nextPageToken, nil
+	}, SendRequestRetryOptions)
+}
+
+// ListAcrossLocations calls list for each of locations and returns their items
+// in order. When several locations are listed, those the API rejects with a
+// 400 or a 404, as it does where the service is not offered, are skipped.
+func ListAcrossLocations[T any](locations []string, list func(location string) ([]T, error)) ([]T, error) {
+	all := make([]T, 0)
+	for _, location := range locations {
+		items, err := list(location)
+		if err != nil {
+			if len(locations) > 1 && (IsGoogleApiErrorWithCode(err, 400) || IsGoogleApiErrorWithCode(err, 404)) {
+				log.Printf("[DEBUG] Skipping location %q: %s", location, err)
+				continue
+			}
+			return nil, err
+		}
+		all = append(all, items...)
+	}
+	return all, nil
+}
@@ -0,0 +1,119 @@
+package transport
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/api/googleapi"
+)
+
+func testListAllPagesRetryOptions(retryFunc func() error) RetryOptions {
+	return RetryOptions{RetryFunc: retryFunc}
+}
+
+func TestListAllPages_singlePage(t *testing.T) {
+	var tokens []string
+	got, err := ListAllPages(func(pageToken string) ([]string, string, error) {
+		tokens = append(tokens, pageToken)
+		return []string{"a", "b"}, "", nil
+	}, testListAllPagesRetryOptions)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []string{"a", "b"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if expected := []string{""}; !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("expected page tokens %v, got %v", expected, tokens)
+	}
+}
+
+func TestListAllPages_multiplePages(t *testing.T) {
+	pages := map[string]struct {
+		items         []string
+		nextPageToken string
+	}{
+		"":      {items: []string{"a", "b"}, nextPageToken: "page2"},
+		"page2": {items: nil, nextPageToken: "page3"},
+		"page3": {items: []string{"c"}},
+	}
+	var tokens []string
+	got, err := ListAllPages(func(pageToken string) ([]string, string, error) {
+		tokens = append(tokens, pageToken)
+		page := pages[pageToken]
+		return page.items, page.nextPageToken, nil
+	}, testListAllPagesRetryOptions)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []string{"a", "b", "c"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if expected := []string{"", "page2", "page3"}; !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("expected page tokens %v, got %v", expected, tokens)
+	}
+}
+
+func TestListAllPages_errorMidPagination(t *testing.T) {
+	var tokens []string
+	got, err := ListAllPages(func(pageToken string) ([]string, string, error) {
+		tokens = append(tokens, pageToken)
+		if pageToken == "page2" {
+			return nil, "", &googleapi.Error{Code: 403}
+		}
+		return []string{"a"}, "page2", nil
+	}, testListAllPagesRetryOptions)
+	if gerr, ok := err.(*googleapi.Error); !ok || gerr.Code != 403 {
+		t.Fatalf("expected the 403 of the second page, got %v", err)
+	}
+	if got != nil {
+		t.Errorf("expected no items, got %v", got)
+	}
+	if expected := []string{"", "page2"}; !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("expected page tokens %v, got %v", expected, tokens)
+	}
+}
+
+func TestListAcrossLocations(t *testing.T) {
+	list := func(location string) ([]string, error) {
+		switch location {
+		case "unavailable":
+			return nil, &googleapi.Error{Code: 400}
+		case "missing":
+			return nil, &googleapi.Error{Code: 404}
+		case "forbidden":
+			return nil, &googleapi.Error{Code: 403}
+		}
+		return []string{location + "-a", location + "-b"}, nil
+	}
+
+	got, err := ListAcrossLocations([]string{"us-central1", "unavailable", "missing", "europe-west1"}, list)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []string{"us-central1-a", "us-central1-b", "europe-west1-a", "europe-west1-b"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if _, err := ListAcrossLocations([]string{"us-central1", "forbidden"}, list); err == nil {
+		t.Errorf("expected the 403 of the forbidden location")
+	}
+	// A single location is never skipped, so a wrong location surfaces.
+	if _, err := ListAcrossLocations([]string{"missing"}, list); err == nil {
+		t.Errorf("expected the 404 of the only location")
+	}
+}
+
+func TestSendRequestRetryOptions_doesNotRetry(t *testing.T) {
+	calls := 0
+	_, err := ListAllPages(func(pageToken string) ([]string, string, error) {
+		calls++
+		return nil, "", &googleapi.Error{Code: 503}
+	}, SendRequestRetryOptions)
+	if err == nil {
+		t.Fatalf("expected the 503 of the page")
+	}
+	if calls != 1 {
+		t.Errorf("expected the page to be fetched once, got %d calls", calls)
+	}
+}
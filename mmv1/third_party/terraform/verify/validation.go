@@ -428,3 +428,39 @@ func ValidateRegexCompiles() schema.SchemaValidateFunc {
 		return
 	}
 }
+
+// ValidateRegexNamedGroups checks that the value compiles as a regex with at
+// least one named group, such as (?P<region>[a-z0-9-]+). Patterns without a
+// named group would only ever extract empty values.
+func ValidateRegexNamedGroups() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errs []error) {
+		value := v.(string)
+		re, err := regexp.Compile(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf(
+				"%s (%s) is not a valid regex pattern: %s", k, value, err))
+			return
+		}
+		if len(RegexNamedGroups(re)) == 0 {
+			errs = append(errs, fmt.Errorf(
+				"%s (%s) must contain at least one named group, for example (?P<name>...)", k, value))
+		}
+		return
+	}
+}
+
+// RegexNamedGroups returns the names of the named groups of re in the order
+// they first appear. A name used by several groups, as in (?P<a>x)|(?P<a>y),
+// is returned once.
+func RegexNamedGroups(re *regexp.Regexp) []string {
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, name := range re.SubexpNames() {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names
+}
@@ -2,6 +2,7 @@ package verify
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -381,3 +382,53 @@ func TestValidateTagKeyAllowedValuesRegex(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRegexNamedGroups(t *testing.T) {
+	cases := []StringValidationTestCase{
+		// No errors
+		{TestName: "one named group", Value: "^projects/(?P<project>[^/]+)$"},
+		{TestName: "named and unnamed groups", Value: "(a|b)-(?P<suffix>[0-9]+)"},
+		{TestName: "duplicate group name", Value: "^(?P<zone>[a-z]+-[a-z]+[0-9]-[a-z])$|^regions/(?P<zone>[^/]+)$"},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "no groups", Value: "^projects/[^/]+$", ExpectError: true},
+		{TestName: "only unnamed groups", Value: "^projects/([^/]+)$", ExpectError: true},
+		{TestName: "invalid regex syntax", Value: "(?P<project>[^/]+", ExpectError: true},
+	}
+
+	es := TestStringValidationCases(cases, ValidateRegexNamedGroups())
+	if len(es) > 0 {
+		t.Errorf("Failed to validate regex named groups: %v", es)
+	}
+}
+
+func TestRegexNamedGroups(t *testing.T) {
+	cases := map[string]struct {
+		Pattern  string
+		Expected []string
+	}{
+		"no groups": {
+			Pattern:  "^foo$",
+			Expected: []string{},
+		},
+		"unnamed groups are skipped": {
+			Pattern:  "(a)(?P<b>b)(c)",
+			Expected: []string{"b"},
+		},
+		"order of first appearance": {
+			Pattern:  "(?P<region>[a-z0-9-]+)/(?P<name>[^/]+)",
+			Expected: []string{"region", "name"},
+		},
+		"duplicate names are returned once": {
+			Pattern:  "(?P<name>a)(?P<other>b)|(?P<name>c)",
+			Expected: []string{"name", "other"},
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := RegexNamedGroups(regexp.MustCompile(tc.Pattern)); !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tn, tc.Expected, got)
+		}
+	}
+}